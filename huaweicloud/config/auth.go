@@ -9,10 +9,13 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/exec"
+	"runtime"
 	"time"
 
 	"github.com/chnsz/golangsdk"
 	huaweisdk "github.com/chnsz/golangsdk/openstack"
+	iamv3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3"
 	iam_model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/model"
 	"github.com/jmespath/go-jmespath"
 	"github.com/mitchellh/go-homedir"
@@ -24,6 +27,8 @@ const (
 	securityKeyURL     string = "http://169.254.169.254/openstack/latest/securitykey"
 	keyExpiresDuration int64  = 600
 	assumeRoleDuration int32  = 24 * 60 * 60
+
+	credentialProcessMode string = "credential_process"
 )
 
 // CLI Shared Config
@@ -44,6 +49,8 @@ type Profile struct {
 	AgencyDomainId   string `json:"agencyDomainId"`
 	AgencyDomainName string `json:"agencyDomainName"`
 	AgencyName       string `json:"agencyName"`
+	// the command to fetch the credentials, it's used when the mode is credential_process
+	CredentialProcess string `json:"credentialProcess"`
 }
 
 func buildClient(c *Config) error {
@@ -51,6 +58,8 @@ func buildClient(c *Config) error {
 		return buildClientByToken(c)
 	} else if c.AccessKey != "" && c.SecretKey != "" {
 		return buildClientByAKSK(c)
+	} else if c.CredentialProcess != "" {
+		return buildClientByProcess(c)
 	} else if c.Password != "" && (c.Username != "" || c.UserID != "") {
 		return buildClientByPassword(c)
 	} else if c.SharedConfigFile != "" {
@@ -141,6 +150,9 @@ func genClient(c *Config, ao golangsdk.AuthOptionsProvider) (*golangsdk.Provider
 	if c.MaxRetries > 0 {
 		client.MaxBackoffRetries = uint(c.MaxRetries)
 		client.RetryBackoffFunc = retryBackoffFunc
+		if len(c.RateLimiters) > 0 {
+			client.RetryBackoffFunc = rateLimitBackoffFunc
+		}
 	}
 
 	// Validate authentication normally.
@@ -307,6 +319,15 @@ func buildClientByConfig(c *Config) error {
 		c.AssumeRoleDomain = providerConfig.AgencyDomainName
 	}
 
+	if providerConfig.Mode == credentialProcessMode || providerConfig.CredentialProcess != "" {
+		if providerConfig.CredentialProcess == "" {
+			return fmt.Errorf("the credentialProcess of profile %s must be specified in %s mode",
+				current, credentialProcessMode)
+		}
+		c.CredentialProcess = providerConfig.CredentialProcess
+		return buildClientByProcess(c)
+	}
+
 	return buildClientByAKSK(c)
 }
 
@@ -351,51 +372,180 @@ func buildClientByPassword(c *Config) error {
 	return genClients(c, projectAuthOptions, domainAuthOptions)
 }
 
-func buildClientByAgency(c *Config) error {
+// AssumeRole is a role (agency) to assume, the roles can be chained and each role will be assumed with the
+// temporary credentials of the previous one.
+type AssumeRole struct {
+	AgencyName string
+	DomainName string
+	DomainID   string
+	// the validity period of the temporary credentials, in seconds
+	Duration int
+	// the session policy in JSON format and the IDs of the system-defined policies used to scope down the
+	// permissions of the temporary credentials
+	Policy    string
+	PolicyIDs []string
+}
+
+// assumeRoleSource is the source credentials used to assume the first role
+type assumeRoleSource struct {
+	AccessKey     string
+	SecretKey     string
+	SecurityToken string
+	// whether the source credentials are temporary and fetched from the credential process or ECS metadata API
+	Reloadable bool
+}
+
+type assumeRoleRequest struct {
+	Body *assumeRoleRequestBody `json:"body,omitempty"`
+}
+
+type assumeRoleRequestBody struct {
+	Auth assumeRoleAuth `json:"auth"`
+}
+
+type assumeRoleAuth struct {
+	Identity assumeRoleIdentity `json:"identity"`
+}
+
+type assumeRoleIdentity struct {
+	Methods    []string                     `json:"methods"`
+	AssumeRole iam_model.IdentityAssumerole `json:"assume_role"`
+	Policy     map[string]interface{}       `json:"policy,omitempty"`
+	PolicyIDs  []string                     `json:"policy_ids,omitempty"`
+}
+
+// getAssumeRoles returns the roles to assume in order, the AssumeRoleAgency and AssumeRoleDomain are used if
+// AssumeRoles is not specified.
+func (c *Config) getAssumeRoles() []AssumeRole {
+	if len(c.AssumeRoles) > 0 {
+		return c.AssumeRoles
+	}
+	if c.AssumeRoleAgency != "" {
+		return []AssumeRole{
+			{
+				AgencyName: c.AssumeRoleAgency,
+				DomainName: c.AssumeRoleDomain,
+			},
+		}
+	}
+	return nil
+}
+
+func assumeRoleByAgency(c *Config, role AssumeRole) (*iam_model.Credential, error) {
 	client, err := c.HcIamV3Client(c.Region)
 	if err != nil {
-		return fmt.Errorf("Error creating Huaweicloud IAM client: %s", err)
+		return nil, fmt.Errorf("Error creating Huaweicloud IAM client: %s", err)
 	}
 
-	request := &iam_model.CreateTemporaryAccessKeyByAgencyRequest{}
-	domainNameAssumeRoleIdentityAssumerole := c.AssumeRoleDomain
-	durationSecondsAssumeRoleIdentityAssumerole := assumeRoleDuration
-	assumeRoleIdentity := &iam_model.IdentityAssumerole{
-		AgencyName:      c.AssumeRoleAgency,
-		DomainName:      &domainNameAssumeRoleIdentityAssumerole,
-		DurationSeconds: &durationSecondsAssumeRoleIdentityAssumerole,
+	durationSeconds := assumeRoleDuration
+	if role.Duration > 0 {
+		durationSeconds = int32(role.Duration)
 	}
-	var listMethodsIdentity = []iam_model.AgencyAuthIdentityMethods{
-		iam_model.GetAgencyAuthIdentityMethodsEnum().ASSUME_ROLE,
+	identity := assumeRoleIdentity{
+		Methods: []string{"assume_role"},
+		AssumeRole: iam_model.IdentityAssumerole{
+			AgencyName:      role.AgencyName,
+			DurationSeconds: &durationSeconds,
+		},
+		PolicyIDs: role.PolicyIDs,
 	}
-	identityAuth := &iam_model.AgencyAuthIdentity{
-		Methods:    listMethodsIdentity,
-		AssumeRole: assumeRoleIdentity,
+	if role.DomainID != "" {
+		identity.AssumeRole.DomainId = &role.DomainID
 	}
-	authbody := &iam_model.AgencyAuth{
-		Identity: identityAuth,
+	if role.DomainName != "" {
+		identity.AssumeRole.DomainName = &role.DomainName
 	}
-	request.Body = &iam_model.CreateTemporaryAccessKeyByAgencyRequestBody{
-		Auth: authbody,
+	if role.Policy != "" {
+		if err := json.Unmarshal([]byte(role.Policy), &identity.Policy); err != nil {
+			return nil, fmt.Errorf("Error parsing the session policy of agency %s: %s", role.AgencyName, err)
+		}
 	}
-	response, err := client.CreateTemporaryAccessKeyByAgency(request)
+
+	request := assumeRoleRequest{
+		Body: &assumeRoleRequestBody{
+			Auth: assumeRoleAuth{
+				Identity: identity,
+			},
+		},
+	}
+	resp, err := client.HcClient.Sync(&request, iamv3.GenReqDefForCreateTemporaryAccessKeyByAgency())
 	if err != nil {
-		return fmt.Errorf("Error Creating temporary accesskey by agency: %s", err)
+		return nil, fmt.Errorf("Error Creating temporary accesskey by agency %s: %s", role.AgencyName, err)
+	}
+
+	response, ok := resp.(*iam_model.CreateTemporaryAccessKeyByAgencyResponse)
+	if !ok || response.Credential == nil {
+		return nil, fmt.Errorf("Error Creating temporary accesskey by agency %s: the credential is empty", role.AgencyName)
+	}
+	return response.Credential, nil
+}
+
+func buildClientByAgency(c *Config) error {
+	// save the source credentials to assume the roles again before the temporary credentials expire
+	if c.assumeRoleSource == nil {
+		c.assumeRoleSource = &assumeRoleSource{
+			AccessKey:     c.AccessKey,
+			SecretKey:     c.SecretKey,
+			SecurityToken: c.SecurityToken,
+			Reloadable:    !c.SecurityKeyExpiresAt.IsZero(),
+		}
+	}
+
+	var expiresAt time.Time
+	for _, role := range c.getAssumeRoles() {
+		credential, err := assumeRoleByAgency(c, role)
+		if err != nil {
+			return err
+		}
+
+		c.AccessKey, c.SecretKey, c.SecurityToken = credential.Access, credential.Secret, credential.Securitytoken
+		expiresAt, err = time.Parse(time.RFC3339, credential.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("Error parsing the expiration time of the temporary credentials: %s", err)
+		}
+		log.Printf("[DEBUG] Successfully assumed the role %s, which will expire at: %s", role.AgencyName, expiresAt)
 	}
-	c.AccessKey, c.SecretKey, c.SecurityToken = response.Credential.Access, response.Credential.Secret, response.Credential.Securitytoken
+	c.SecurityKeyExpiresAt = expiresAt
 
 	return buildClientByAKSK(c)
 }
 
 func (c *Config) reloadSecurityKey() error {
-	err := getAuthConfigByMeta(c)
-	if err != nil {
-		return fmt.Errorf("Error reloading Auth credentials from ECS Metadata API: %s", err)
+	if source := c.assumeRoleSource; source != nil {
+		if source.Reloadable {
+			if err := c.reloadSourceCredentials(); err != nil {
+				return err
+			}
+		} else {
+			c.AccessKey, c.SecretKey, c.SecurityToken = source.AccessKey, source.SecretKey, source.SecurityToken
+		}
+
+		log.Printf("[DEBUG] Reload the temporary credentials by assuming the roles again")
+		return buildClientByAgency(c)
 	}
-	log.Printf("Successfully reload metadata security key, which will expire at: %s", c.SecurityKeyExpiresAt)
+
+	if err := c.reloadSourceCredentials(); err != nil {
+		return err
+	}
+	log.Printf("Successfully reload security key, which will expire at: %s", c.SecurityKeyExpiresAt)
 	return buildClientByAKSK(c)
 }
 
+// reloadSourceCredentials reloads the temporary credentials from the credential process or ECS metadata API.
+func (c *Config) reloadSourceCredentials() error {
+	if c.CredentialProcess != "" {
+		if err := getAuthConfigByProcess(c); err != nil {
+			return fmt.Errorf("Error reloading Auth credentials from credential process: %s", err)
+		}
+		return nil
+	}
+
+	if err := getAuthConfigByMeta(c); err != nil {
+		return fmt.Errorf("Error reloading Auth credentials from ECS Metadata API: %s", err)
+	}
+	return nil
+}
+
 func getAuthConfigByMeta(c *Config) error {
 	req, err := http.NewRequest("GET", securityKeyURL, nil)
 	if err != nil {
@@ -461,3 +611,59 @@ func buildClientByMeta(c *Config) error {
 	log.Printf("[DEBUG] Successfully got metadata security key, which will expire at: %s", c.SecurityKeyExpiresAt)
 	return buildClientByAKSK(c)
 }
+
+// processCredential is the JSON output of the credential process, the expires_at is optional and
+// the credentials will be refreshed before it expires.
+type processCredential struct {
+	Access        string `json:"access"`
+	Secret        string `json:"secret"`
+	SecurityToken string `json:"securitytoken"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+func getAuthConfigByProcess(c *Config) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", c.CredentialProcess)
+	} else {
+		cmd = exec.Command("sh", "-c", c.CredentialProcess)
+	}
+	cmd.Stderr = os.Stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("Error running the credential process: %s", err)
+	}
+
+	var credential processCredential
+	if err := json.Unmarshal(output, &credential); err != nil {
+		return fmt.Errorf("Error parsing the output of the credential process: %s", err)
+	}
+	if credential.Access == "" || credential.Secret == "" {
+		return fmt.Errorf("the access and secret must be returned by the credential process")
+	}
+
+	var expiresAt time.Time
+	if credential.ExpiresAt != "" {
+		expiresAt, err = time.Parse(time.RFC3339, credential.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("Error parsing the expires_at returned by the credential process: %s", err)
+		}
+	}
+	c.AccessKey, c.SecretKey, c.SecurityToken, c.SecurityKeyExpiresAt = credential.Access, credential.Secret,
+		credential.SecurityToken, expiresAt
+
+	return nil
+}
+
+func buildClientByProcess(c *Config) error {
+	err := getAuthConfigByProcess(c)
+	if err != nil {
+		return fmt.Errorf("Error fetching Auth credentials from credential process: %s", err)
+	}
+	if !c.SecurityKeyExpiresAt.IsZero() {
+		log.Printf("[DEBUG] Successfully got the credentials from credential process, which will expire at: %s",
+			c.SecurityKeyExpiresAt)
+	}
+	return buildClientByAKSK(c)
+}
@@ -0,0 +1,400 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	hcconfig "github.com/huaweicloud/huaweicloud-sdk-go-v3/core/config"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+/*
+This file is used to impl the cassette mode of LogRoundTripper, which records the HTTP interactions into a cassette
+file and replays them later, so that the acceptance tests can be run without credentials or network.
+The huaweicloud-sdk-go-v3 clients build their own transport, so their connections are routed to a local HTTPS server
+which records and replays the requests through the same cassette.
+
+The cassette mode is driven by the following environment variables:
+  - HW_VCR_MODE: the cassette mode, the valid values are record and replay.
+  - HW_VCR_CASSETTE_DIR: the directory to save the cassette files, defaults to the current working directory.
+  - HW_VCR_CASSETTE_NAME: the name of the cassette file, defaults to the name of the running binary, e.g. vpc.test.
+*/
+
+const (
+	VcrModeRecord = "record"
+	VcrModeReplay = "replay"
+
+	cassetteFileSuffix = ".cassette.jsonl"
+)
+
+// Interaction is an HTTP request/response pair saved in the cassette file.
+type Interaction struct {
+	Method          string      `json:"method"`
+	URL             string      `json:"url"`
+	RequestBody     string      `json:"request_body,omitempty"`
+	StatusCode      int         `json:"status_code"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    string      `json:"response_body,omitempty"`
+}
+
+// Cassette is used to record or replay the HTTP interactions.
+type Cassette struct {
+	Mode string
+	Path string
+
+	// interactions is a map which stores the recorded interactions, the key is the method, normalized URL and the
+	// hash of the masked body of the request. The interactions with the same key will be replayed in the order of
+	// recording.
+	interactions map[string][]Interaction
+	lock         sync.Mutex
+
+	// the local HTTPS server which serves the requests of the huaweicloud-sdk-go-v3 clients
+	serverOnce sync.Once
+	serverAddr string
+	serverErr  error
+	// transport is used to send the requests of the huaweicloud-sdk-go-v3 clients to the cloud in record mode
+	transport http.RoundTripper
+}
+
+var (
+	cassette     *Cassette
+	cassetteOnce sync.Once
+)
+
+// GetVcrMode returns the cassette mode which is set by the HW_VCR_MODE environment variable.
+func GetVcrMode() string {
+	mode := strings.ToLower(os.Getenv("HW_VCR_MODE"))
+	if mode == VcrModeRecord || mode == VcrModeReplay {
+		return mode
+	}
+	return ""
+}
+
+// getCassette returns the cassette shared by all clients, or nil if the cassette mode is not enabled.
+func getCassette() *Cassette {
+	cassetteOnce.Do(func() {
+		mode := GetVcrMode()
+		if mode == "" {
+			return
+		}
+
+		name := os.Getenv("HW_VCR_CASSETTE_NAME")
+		if name == "" {
+			name = filepath.Base(os.Args[0])
+		}
+		path := filepath.Join(os.Getenv("HW_VCR_CASSETTE_DIR"), name+cassetteFileSuffix)
+
+		c, err := newCassette(mode, path)
+		if err != nil {
+			log.Printf("[WARN] failed to open the cassette file %s, the cassette mode is disabled: %s", path, err)
+			return
+		}
+		log.Printf("[DEBUG] the cassette mode %s is enabled with file: %s", mode, path)
+		cassette = c
+	})
+
+	return cassette
+}
+
+func newCassette(mode, path string) (*Cassette, error) {
+	c := Cassette{
+		Mode:         mode,
+		Path:         path,
+		interactions: make(map[string][]Interaction),
+	}
+
+	if mode == VcrModeRecord {
+		// truncate the cassette file when recording
+		return &c, os.WriteFile(path, nil, 0600)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var item Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &item); err != nil {
+			return nil, fmt.Errorf("error parsing the cassette file: %s", err)
+		}
+		key := interactionKey(item.Method, item.URL, item.RequestBody)
+		c.interactions[key] = append(c.interactions[key], item)
+	}
+
+	return &c, scanner.Err()
+}
+
+// interactionKey returns the key to match the interactions, the query parameters of the URL are sorted so that
+// the requests built from maps are matched regardless of the order of the parameters, and the hash of the masked
+// body is appended so that the requests sent to the same URL with different bodies are matched respectively.
+func interactionKey(method, rawURL, maskedBody string) string {
+	if u, err := url.Parse(rawURL); err == nil && u.RawQuery != "" {
+		u.RawQuery = u.Query().Encode()
+		rawURL = u.String()
+	}
+	if maskedBody == "" {
+		return fmt.Sprintf("%s %s", method, rawURL)
+	}
+
+	sum := sha256.Sum256([]byte(maskedBody))
+	return fmt.Sprintf("%s %s %s", method, rawURL, hex.EncodeToString(sum[:8]))
+}
+
+// record saves the request/response pair into the cassette file and returns a new response body.
+func (c *Cassette) record(request *http.Request, requestBody []byte, response *http.Response) (io.ReadCloser, error) {
+	var bs bytes.Buffer
+	if response.Body != nil {
+		defer response.Body.Close()
+		if _, err := io.Copy(&bs, response.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	item := Interaction{
+		Method:          request.Method,
+		URL:             request.URL.String(),
+		RequestBody:     maskCassetteBody(requestBody),
+		StatusCode:      response.StatusCode,
+		ResponseHeaders: maskCassetteHeaders(response.Header),
+		ResponseBody:    maskCassetteBody(bs.Bytes()),
+	}
+
+	line, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	file, err := os.OpenFile(c.Path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(bs.Bytes())), nil
+}
+
+// replay serves the recorded response which matches the method, URL and body of the request.
+func (c *Cassette) replay(request *http.Request, requestBody []byte) (*http.Response, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := interactionKey(request.Method, request.URL.String(), maskCassetteBody(requestBody))
+	items := c.interactions[key]
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no recorded interaction matches the request %s in the cassette file %s", key, c.Path)
+	}
+
+	item := items[0]
+	// always keep the last interaction to serve the subsequent polling requests
+	if len(items) > 1 {
+		c.interactions[key] = items[1:]
+	}
+
+	log.Printf("[DEBUG] replay the recorded response of %s: %d", key, item.StatusCode)
+	header := item.ResponseHeaders
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", item.StatusCode, http.StatusText(item.StatusCode)),
+		StatusCode:    item.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(item.ResponseBody)),
+		ContentLength: int64(len(item.ResponseBody)),
+		Request:       request,
+	}, nil
+}
+
+// dialContext returns a dialer which routes the connections of the huaweicloud-sdk-go-v3 clients to the local HTTPS
+// server of the cassette, the server sends the requests to the cloud with the verification specified by insecure.
+func (c *Cassette) dialContext(insecure bool) hcconfig.DialContext {
+	return func(ctx context.Context, network, _ string) (net.Conn, error) {
+		addr, err := c.startServer(insecure)
+		if err != nil {
+			return nil, fmt.Errorf("error starting the local server of the cassette: %s", err)
+		}
+
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, network, addr)
+	}
+}
+
+func (c *Cassette) startServer(insecure bool) (string, error) {
+	c.serverOnce.Do(func() {
+		cert, err := newCassetteCertificate()
+		if err != nil {
+			c.serverErr = err
+			return
+		}
+
+		listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		})
+		if err != nil {
+			c.serverErr = err
+			return
+		}
+
+		c.transport = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			// #nosec G402
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+		}
+		c.serverAddr = listener.Addr().String()
+		server := http.Server{
+			Handler:           c,
+			ReadHeaderTimeout: time.Minute,
+		}
+		go func() {
+			if err := server.Serve(listener); err != nil {
+				log.Printf("[WARN] the local server of the cassette is stopped: %s", err)
+			}
+		}()
+	})
+
+	return c.serverAddr, c.serverErr
+}
+
+// ServeHTTP records or replays the requests which are routed from the huaweicloud-sdk-go-v3 clients.
+func (c *Cassette) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var requestBody []byte
+	if r.Body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	request := r.Clone(r.Context())
+	request.RequestURI = ""
+	request.URL.Scheme = "https"
+	request.URL.Host = r.Host
+	request.Body = io.NopCloser(bytes.NewReader(requestBody))
+	// let the transport negotiate the compression and decompress the response body
+	request.Header.Del("Accept-Encoding")
+
+	response, err := c.roundTrip(request, requestBody)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer response.Body.Close()
+
+	for name, values := range response.Header {
+		// the body may be masked, so the length is calculated by the server
+		if name == "Content-Length" || name == "Transfer-Encoding" || name == "Connection" {
+			continue
+		}
+		w.Header()[name] = values
+	}
+	w.WriteHeader(response.StatusCode)
+	if _, err := io.Copy(w, response.Body); err != nil {
+		log.Printf("[WARN] error writing the response of %s %s: %s", request.Method, request.URL, err)
+	}
+}
+
+func (c *Cassette) roundTrip(request *http.Request, requestBody []byte) (*http.Response, error) {
+	if c.Mode == VcrModeReplay {
+		return c.replay(request, requestBody)
+	}
+
+	response, err := c.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	response.Body, err = c.record(request, requestBody, response)
+	return response, err
+}
+
+// newCassetteCertificate generates a self-signed certificate for the local server of the cassette.
+func newCassetteCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{"terraform-provider-huaweicloud cassette"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// maskCassetteHeaders masks the sensitive headers, e.g. X-Subject-Token, before they are saved to the cassette file.
+func maskCassetteHeaders(headers http.Header) http.Header {
+	sensitiveWords := []string{"token", "authorization"}
+
+	result := make(http.Header, len(headers))
+	for name, values := range headers {
+		if utils.IsStrContainsSliceElement(name, sensitiveWords, true, false) {
+			result[name] = []string{"***"}
+		} else {
+			result[name] = values
+		}
+	}
+	return result
+}
+
+// maskCassetteBody masks the security fields of the JSON body before it is saved to the cassette file,
+// and the non-JSON body will be saved as it is.
+func maskCassetteBody(raw []byte) string {
+	var data map[string]interface{}
+	if len(raw) == 0 || json.Unmarshal(raw, &data) != nil {
+		return string(raw)
+	}
+
+	maskFields(data, false)
+	masked, err := json.Marshal(data)
+	if err != nil {
+		return string(raw)
+	}
+	return string(masked)
+}
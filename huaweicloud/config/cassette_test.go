@@ -0,0 +1,121 @@
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	th "github.com/chnsz/golangsdk/testhelper"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v1/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "secret-token")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"server":{"id":"server-id","adminPass":"secret-password"}}`)
+	})
+
+	path := filepath.Join(t.TempDir(), "test"+cassetteFileSuffix)
+	recorder, err := newCassette(VcrModeRecord, path)
+	th.AssertNoErr(t, err)
+
+	requestBody := `{"server":{"name":"test","adminPass":"secret-password"}}`
+	request, err := http.NewRequest("POST", th.Endpoint()+"v1/servers", strings.NewReader(requestBody))
+	th.AssertNoErr(t, err)
+	response, err := http.DefaultClient.Do(request)
+	th.AssertNoErr(t, err)
+	body, err := recorder.record(request, []byte(requestBody), response)
+	th.AssertNoErr(t, err)
+	raw, err := io.ReadAll(body)
+	th.AssertNoErr(t, err)
+	// the original response body should be returned to the caller
+	th.AssertEquals(t, true, strings.Contains(string(raw), "secret-password"))
+
+	player, err := newCassette(VcrModeReplay, path)
+	th.AssertNoErr(t, err)
+
+	request, err = http.NewRequest("POST", th.Endpoint()+"v1/servers", nil)
+	th.AssertNoErr(t, err)
+	response, err = player.replay(request, []byte(requestBody))
+	th.AssertNoErr(t, err)
+	raw, err = io.ReadAll(response.Body)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, http.StatusOK, response.StatusCode)
+	th.AssertEquals(t, "***", response.Header.Get("X-Subject-Token"))
+	th.AssertEquals(t, false, strings.Contains(string(raw), "secret-password"))
+	th.AssertEquals(t, true, strings.Contains(string(raw), "server-id"))
+
+	request, err = http.NewRequest("GET", th.Endpoint()+"v1/servers", nil)
+	th.AssertNoErr(t, err)
+	_, err = player.replay(request, nil)
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestCassetteReplayByRequestBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test"+cassetteFileSuffix)
+	items := []string{
+		`{"method":"POST","url":"https://ecs.example.com/v1/tags","request_body":"{\"key\":\"foo\"}",` +
+			`"status_code":200,"response_body":"{\"id\":\"foo-id\"}"}`,
+		`{"method":"POST","url":"https://ecs.example.com/v1/tags","request_body":"{\"key\":\"bar\"}",` +
+			`"status_code":200,"response_body":"{\"id\":\"bar-id\"}"}`,
+	}
+	th.AssertNoErr(t, os.WriteFile(path, []byte(strings.Join(items, "\n")+"\n"), 0600))
+
+	player, err := newCassette(VcrModeReplay, path)
+	th.AssertNoErr(t, err)
+
+	// the requests are sent in another order than recording, e.g. by the parallel tests
+	for _, key := range []string{"bar", "foo"} {
+		request, err := http.NewRequest("POST", "https://ecs.example.com/v1/tags", nil)
+		th.AssertNoErr(t, err)
+		response, err := player.replay(request, []byte(fmt.Sprintf(`{"key": "%s"}`, key)))
+		th.AssertNoErr(t, err)
+		raw, err := io.ReadAll(response.Body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, fmt.Sprintf(`{"id":"%s-id"}`, key), string(raw))
+	}
+
+	request, err := http.NewRequest("POST", "https://ecs.example.com/v1/tags", nil)
+	th.AssertNoErr(t, err)
+	_, err = player.replay(request, []byte(`{"key":"baz"}`))
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestCassetteReplayThroughLocalServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test"+cassetteFileSuffix)
+	item := `{"method":"GET","url":"https://vpc.example.com/v1/vpcs?limit=10&name=test","status_code":200,` +
+		`"response_headers":{"Content-Type":["application/json"],"Content-Length":["1"]},` +
+		`"response_body":"{\"vpcs\":[{\"id\":\"vpc-id\"}]}"}`
+	th.AssertNoErr(t, os.WriteFile(path, []byte(item+"\n"), 0600))
+
+	player, err := newCassette(VcrModeReplay, path)
+	th.AssertNoErr(t, err)
+
+	// route the connections as the huaweicloud-sdk-go-v3 clients do, and the query parameters are in another order
+	client := http.Client{
+		Transport: &http.Transport{
+			DialContext: player.dialContext(false),
+			// #nosec G402
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	response, err := client.Get("https://vpc.example.com/v1/vpcs?name=test&limit=10")
+	th.AssertNoErr(t, err)
+	defer response.Body.Close()
+	raw, err := io.ReadAll(response.Body)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, http.StatusOK, response.StatusCode)
+	th.AssertEquals(t, "application/json", response.Header.Get("Content-Type"))
+	th.AssertEquals(t, `{"vpcs":[{"id":"vpc-id"}]}`, string(raw))
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+/*
+This file is used to impl the service catalog file, which redefines the built-in service catalogs and the endpoint
+templates for the private clouds (e.g. HCSO) and the sovereign regions. The file can be JSON or YAML format, e.g.
+
+	host_template: https://{name}.{region}.example.com/
+	global_host_template: https://{name}.example.com/
+	catalogs:
+	  ecs:
+	    version: v1.1
+	  dcs:
+	    name: dcs-api
+	    host_template: https://api.{region}.example.com/dcs/
+	  newservice:
+	    name: newservice
+	    version: v1
+	    scope: global
+	    without_project_id: true
+
+The placeholders {name}, {region} and {cloud} of the host templates will be replaced with the catalog name,
+the region and the cloud of the provider.
+*/
+
+// catalogOverride is the definition of a catalog key in the service catalog file,
+// the nil fields will keep the values of the built-in catalog.
+type catalogOverride struct {
+	Name             *string `json:"name" yaml:"name"`
+	Version          *string `json:"version" yaml:"version"`
+	Scope            *string `json:"scope" yaml:"scope"`
+	Admin            *bool   `json:"admin" yaml:"admin"`
+	ResourceBase     *string `json:"resource_base" yaml:"resource_base"`
+	WithOutProjectID *bool   `json:"without_project_id" yaml:"without_project_id"`
+	HostTemplate     *string `json:"host_template" yaml:"host_template"`
+}
+
+// serviceCatalogFile is the content of the service catalog file.
+type serviceCatalogFile struct {
+	HostTemplate       string                     `json:"host_template" yaml:"host_template"`
+	GlobalHostTemplate string                     `json:"global_host_template" yaml:"global_host_template"`
+	Catalogs           map[string]catalogOverride `json:"catalogs" yaml:"catalogs"`
+}
+
+// parseServiceCatalogFile parses the service catalog file, the file with .json extension will be parsed as JSON,
+// and others will be parsed as YAML.
+func parseServiceCatalogFile(path string) (*serviceCatalogFile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the service catalog file %s: %s", path, err)
+	}
+
+	var content serviceCatalogFile
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(raw, &content)
+	} else {
+		err = yaml.Unmarshal(raw, &content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the service catalog file %s: %s", path, err)
+	}
+	return &content, nil
+}
+
+func applyCatalogOverride(catalog ServiceCatalog, override catalogOverride) ServiceCatalog {
+	if override.Name != nil {
+		catalog.Name = *override.Name
+	}
+	if override.Version != nil {
+		catalog.Version = *override.Version
+	}
+	if override.Scope != nil {
+		catalog.Scope = *override.Scope
+	}
+	if override.Admin != nil {
+		catalog.Admin = *override.Admin
+	}
+	if override.ResourceBase != nil {
+		catalog.ResourceBase = *override.ResourceBase
+	}
+	if override.WithOutProjectID != nil {
+		catalog.WithOutProjectID = *override.WithOutProjectID
+	}
+	if override.HostTemplate != nil {
+		catalog.HostTemplate = *override.HostTemplate
+	}
+	return catalog
+}
+
+// loadServiceCatalogFile loads the service catalog file into the Config,
+// the catalog keys which are not built-in will be added as new catalogs.
+func (c *Config) loadServiceCatalogFile() error {
+	if c.ServiceCatalogFile == "" {
+		return nil
+	}
+
+	content, err := parseServiceCatalogFile(c.ServiceCatalogFile)
+	if err != nil {
+		return err
+	}
+
+	c.hostTemplate = content.HostTemplate
+	c.globalHostTemplate = content.GlobalHostTemplate
+	c.serviceCatalogs = make(map[string]ServiceCatalog, len(content.Catalogs))
+	for key, override := range content.Catalogs {
+		catalog, ok := allServiceCatalog[key]
+		if !ok {
+			log.Printf("[DEBUG] add the service catalog %s from the service catalog file", key)
+		}
+
+		catalog = applyCatalogOverride(catalog, override)
+		if catalog.Name == "" {
+			return fmt.Errorf("the name of the service catalog %s is missing in the service catalog file", key)
+		}
+		if catalog.Scope != "" && catalog.Scope != "global" {
+			return fmt.Errorf("the scope of the service catalog %s is invalid, only global is supported", key)
+		}
+		c.serviceCatalogs[key] = catalog
+	}
+
+	log.Printf("[DEBUG] the service catalog file %s is loaded", c.ServiceCatalogFile)
+	return nil
+}
+
+// getServiceCatalog returns the catalog of the service, which is redefined by the service catalog file if specified.
+func (c *Config) getServiceCatalog(srv string) (ServiceCatalog, bool) {
+	if catalog, ok := c.serviceCatalogs[srv]; ok {
+		return catalog, true
+	}
+	catalog, ok := allServiceCatalog[srv]
+	return catalog, ok
+}
+
+// buildServiceEndpoint returns the endpoint of the service catalog in the region,
+// the default endpoint likes https://{Name}.{Region}.{Cloud}/ or https://{Name}.{Cloud}/ for the global services.
+func (c *Config) buildServiceEndpoint(catalog ServiceCatalog, region string) string {
+	isGlobal := catalog.Scope == "global" && !c.RegionClient
+
+	template := catalog.HostTemplate
+	if template == "" {
+		if isGlobal {
+			template = c.globalHostTemplate
+		} else {
+			template = c.hostTemplate
+		}
+	}
+
+	if template == "" {
+		if isGlobal {
+			return fmt.Sprintf("https://%s.%s/", catalog.Name, c.Cloud)
+		}
+		return fmt.Sprintf("https://%s.%s.%s/", catalog.Name, region, c.Cloud)
+	}
+
+	endpoint := strings.NewReplacer(
+		"{name}", catalog.Name,
+		"{region}", region,
+		"{cloud}", c.Cloud,
+	).Replace(template)
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+	return endpoint
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	th "github.com/chnsz/golangsdk/testhelper"
+)
+
+func TestServiceCatalogFile(t *testing.T) {
+	content := `
+host_template: https://{name}.{region}.example.com/
+global_host_template: https://{name}.example.com
+catalogs:
+  ecs:
+    version: v1.1
+  dcs:
+    name: dcs-api
+    host_template: https://api.{region}.example.com/dcs
+  newservice:
+    name: newsrv
+    version: ""
+    scope: global
+    without_project_id: true
+`
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	th.AssertNoErr(t, os.WriteFile(path, []byte(content), 0600))
+
+	cfg := &Config{
+		Cloud:              "myhuaweicloud.com",
+		ServiceCatalogFile: path,
+	}
+	th.AssertNoErr(t, cfg.loadServiceCatalogFile())
+
+	ecs, ok := cfg.getServiceCatalog("ecs")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "ecs", ecs.Name)
+	th.AssertEquals(t, "v1.1", ecs.Version)
+	th.AssertEquals(t, "https://ecs.cn-north-4.example.com/", GetServiceEndpoint(cfg, "ecs", "cn-north-4"))
+
+	th.AssertEquals(t, "https://api.cn-north-4.example.com/dcs/", GetServiceEndpoint(cfg, "dcs", "cn-north-4"))
+	th.AssertEquals(t, "https://newsrv.example.com/", GetServiceEndpoint(cfg, "newservice", "cn-north-4"))
+	// the catalogs which are not redefined use the host templates of the file
+	th.AssertEquals(t, "https://vpc.cn-north-4.example.com/", GetServiceEndpoint(cfg, "vpc", "cn-north-4"))
+
+	// the custom endpoints still have the highest priority
+	cfg.Endpoints = map[string]string{"ecs": "https://ecs.custom.com/"}
+	th.AssertEquals(t, "https://ecs.custom.com/", GetServiceEndpoint(cfg, "ecs", "cn-north-4"))
+}
+
+func TestServiceCatalogFileJSON(t *testing.T) {
+	content := `{
+	"catalogs": {
+		"newservice": {"version": "v2"}
+	}
+}`
+	path := filepath.Join(t.TempDir(), "catalog.json")
+	th.AssertNoErr(t, os.WriteFile(path, []byte(content), 0600))
+
+	cfg := &Config{
+		Cloud:              "myhuaweicloud.com",
+		ServiceCatalogFile: path,
+	}
+	// the name is required for the new catalog key
+	err := cfg.loadServiceCatalogFile()
+	th.AssertEquals(t, true, err != nil)
+
+	// the default endpoint pattern is used without the service catalog file
+	cfg = &Config{Cloud: "myhuaweicloud.com"}
+	th.AssertNoErr(t, cfg.loadServiceCatalogFile())
+	th.AssertEquals(t, "https://vpc.cn-north-4.myhuaweicloud.com/", GetServiceEndpoint(cfg, "vpc", "cn-north-4"))
+}
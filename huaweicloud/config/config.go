@@ -46,6 +46,7 @@ type Config struct {
 	SecurityToken       string
 	AssumeRoleAgency    string
 	AssumeRoleDomain    string
+	AssumeRoles         []AssumeRole
 	Cloud               string
 	MaxRetries          int
 	TerraformVersion    string
@@ -53,16 +54,48 @@ type Config struct {
 	EnterpriseProjectID string
 	SharedConfigFile    string
 	Profile             string
+	CredentialProcess   string
 
-	// metadata security key expires at
+	// metadata security key or the temporary credentials of assume role expires at
 	SecurityKeyExpiresAt time.Time
 
+	// the source credentials used to assume the roles
+	assumeRoleSource *assumeRoleSource
+
 	HwClient     *golangsdk.ProviderClient
 	DomainClient *golangsdk.ProviderClient
 
 	// the custom endpoints used to override the default endpoint URL
 	Endpoints map[string]string
 
+	// ServiceCatalogFile is the path of the file which redefines the service catalogs and the endpoint templates
+	ServiceCatalogFile string
+	// the service catalogs and the endpoint templates loaded from the service catalog file
+	serviceCatalogs    map[string]ServiceCatalog
+	hostTemplate       string
+	globalHostTemplate string
+
+	// the default tags which will be merged into the tags of every taggable resource
+	DefaultTags map[string]interface{}
+
+	// the tag keys and key prefixes which will be ignored when saving the tags to the state
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+
+	// RateLimiters is a map which stores the rate limiters of the service catalogs,
+	// and the catalog key will be the key and the limiter shared by all clients of the catalog will be the value.
+	RateLimiters map[string]*RateLimiter
+
+	// the resource type and Terraform operation which are written into the API trace records
+	traceResourceType string
+	traceOperation    string
+	// provider is the Config of the provider which owns the credentials and clients, it is only set for the Config
+	// carrying the trace information, so the reloaded credentials are shared by all Terraform operations
+	provider *Config
+
+	// the regions listed by the preflight checks, which are used to check the regions of the resources
+	preflightRegions []string
+
 	// RegionProjectIDMap is a map which stores the region-projectId pairs,
 	// and region name will be the key and projectID will be the value in this map.
 	RegionProjectIDMap map[string]string
@@ -89,6 +122,10 @@ func (c *Config) LoadAndValidate() error {
 		return fmt.Errorf("max_retries should be a positive value")
 	}
 
+	if err := c.loadServiceCatalogFile(); err != nil {
+		return err
+	}
+
 	err := buildClient(c)
 	if err != nil {
 		return err
@@ -99,7 +136,7 @@ func (c *Config) LoadAndValidate() error {
 	}
 
 	// Assume role
-	if c.AssumeRoleAgency != "" {
+	if len(c.getAssumeRoles()) > 0 {
 		err = buildClientByAgency(c)
 		if err != nil {
 			return err
@@ -177,6 +214,10 @@ func (c *Config) ObjectStorageClientWithSignature(region string) (*obs.ObsClient
 		return nil, fmt.Errorf("missing credentials for OBS, need access_key and secret_key values for provider")
 	}
 
+	if err := c.reloadSecurityKeyIfExpiring(); err != nil {
+		return nil, err
+	}
+
 	clientConfigure := obs.WithHttpClient(&c.DomainClient.HTTPClient)
 	userAgentConfigure := obs.WithUserAgent(buildObsUserAgent())
 	obsEndpoint := getObsEndpoint(c, region)
@@ -193,17 +234,8 @@ func (c *Config) ObjectStorageClient(region string) (*obs.ObsClient, error) {
 		return nil, fmt.Errorf("missing credentials for OBS, need access_key and secret_key values for provider")
 	}
 
-	if !c.SecurityKeyExpiresAt.IsZero() {
-		c.SecurityKeyLock.Lock()
-		defer c.SecurityKeyLock.Unlock()
-		timeNow := time.Now().Unix()
-		expairesAtInt := c.SecurityKeyExpiresAt.Unix()
-		if timeNow+keyExpiresDuration > expairesAtInt {
-			err := c.reloadSecurityKey()
-			if err != nil {
-				return nil, err
-			}
-		}
+	if err := c.reloadSecurityKeyIfExpiring(); err != nil {
+		return nil, err
 	}
 
 	clientConfigure := obs.WithHttpClient(&c.DomainClient.HTTPClient)
@@ -216,6 +248,57 @@ func (c *Config) ObjectStorageClient(region string) (*obs.ObsClient, error) {
 	return obs.New(c.AccessKey, c.SecretKey, obsEndpoint, clientConfigure, userAgentConfigure)
 }
 
+// reloadSecurityKeyIfExpiring reloads the temporary security key when it is about to expire.
+func (c *Config) reloadSecurityKeyIfExpiring() error {
+	if c.provider != nil {
+		return c.syncProviderCredentials()
+	}
+	if c.SecurityKeyExpiresAt.IsZero() {
+		return nil
+	}
+
+	c.SecurityKeyLock.Lock()
+	defer c.SecurityKeyLock.Unlock()
+	timeNow := time.Now().Unix()
+	expairesAtInt := c.SecurityKeyExpiresAt.Unix()
+	if timeNow+keyExpiresDuration > expairesAtInt {
+		return c.reloadSecurityKey()
+	}
+	return nil
+}
+
+// getSecurityKey reloads the temporary security key if it is about to expire, and returns the AK/SK and the security
+// token. The Configs carrying the trace information read them from the provider Config which owns the credentials.
+func (c *Config) getSecurityKey() (ak, sk, securityToken string, err error) {
+	if err = c.reloadSecurityKeyIfExpiring(); err != nil {
+		return "", "", "", err
+	}
+
+	p := c
+	if c.provider != nil {
+		p = c.provider
+	}
+	p.SecurityKeyLock.Lock()
+	defer p.SecurityKeyLock.Unlock()
+	return p.AccessKey, p.SecretKey, p.SecurityToken, nil
+}
+
+// syncProviderCredentials reloads the credentials of the provider Config if they are about to expire,
+// and then uses the credentials and clients of the provider Config.
+func (c *Config) syncProviderCredentials() error {
+	p := c.provider
+	if err := p.reloadSecurityKeyIfExpiring(); err != nil {
+		return err
+	}
+
+	p.SecurityKeyLock.Lock()
+	defer p.SecurityKeyLock.Unlock()
+	c.AccessKey, c.SecretKey, c.SecurityToken = p.AccessKey, p.SecretKey, p.SecurityToken
+	c.SecurityKeyExpiresAt = p.SecurityKeyExpiresAt
+	c.HwClient, c.DomainClient = p.HwClient, p.DomainClient
+	return nil
+}
+
 func buildObsUserAgent() string {
 	var agent string = providerUserAgent
 	if customUserAgent := os.Getenv("HW_TF_CUSTOM_UA"); customUserAgent != "" {
@@ -229,28 +312,20 @@ func buildObsUserAgent() string {
 // If you want to add new ServiceClient, please make sure the catalog was already in allServiceCatalog.
 // the endpoint likes https://{Name}.{Region}.myhuaweicloud.com/{Version}/{project_id}/{ResourceBase}
 func (c *Config) NewServiceClient(srv, region string) (*golangsdk.ServiceClient, error) {
-	serviceCatalog, ok := allServiceCatalog[srv]
+	serviceCatalog, ok := c.getServiceCatalog(srv)
 	if !ok {
 		return nil, fmt.Errorf("service type %s is invalid or not supportted", srv)
 	}
 
-	if !c.SecurityKeyExpiresAt.IsZero() {
-		c.SecurityKeyLock.Lock()
-		defer c.SecurityKeyLock.Unlock()
-		timeNow := time.Now().Unix()
-		expairesAtInt := c.SecurityKeyExpiresAt.Unix()
-		if timeNow+keyExpiresDuration > expairesAtInt {
-			err := c.reloadSecurityKey()
-			if err != nil {
-				return nil, err
-			}
-		}
+	if err := c.reloadSecurityKeyIfExpiring(); err != nil {
+		return nil, err
 	}
 
 	client := c.HwClient
 	if serviceCatalog.Admin {
 		client = c.DomainClient
 	}
+	client = c.withCustomTransport(client, srv)
 
 	if endpoint, ok := c.Endpoints[srv]; ok {
 		return c.newServiceClientByEndpoint(client, srv, endpoint)
@@ -258,6 +333,31 @@ func (c *Config) NewServiceClient(srv, region string) (*golangsdk.ServiceClient,
 	return c.newServiceClientByName(client, serviceCatalog, region)
 }
 
+// withCustomTransport returns a copy of the ProviderClient whose requests are limited by the rate limiter of the
+// service catalog and traced with the resource type and operation, or the original ProviderClient if neither the
+// rate limit nor the trace information is configured.
+func (c *Config) withCustomTransport(client *golangsdk.ProviderClient, srv string) *golangsdk.ProviderClient {
+	limiter := c.RateLimiters[srv]
+	if client == nil || (limiter == nil && c.traceResourceType == "") {
+		return client
+	}
+
+	lrt, ok := client.HTTPClient.Transport.(*LogRoundTripper)
+	if !ok {
+		return client
+	}
+
+	lrtClone := *lrt
+	lrtClone.RateLimiter = limiter
+	lrtClone.ResourceType = c.traceResourceType
+	lrtClone.Operation = c.traceOperation
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *client
+	clone.HTTPClient.Transport = &lrtClone
+	return clone
+}
+
 func (c *Config) newServiceClientByName(client *golangsdk.ProviderClient, catalog ServiceCatalog, region string) (*golangsdk.ServiceClient, error) {
 	if catalog.Name == "" {
 		return nil, fmt.Errorf("must specify the service name")
@@ -292,11 +392,7 @@ func (c *Config) newServiceClientByName(client *golangsdk.ProviderClient, catalo
 		ProviderClient: clone,
 	}
 
-	if catalog.Scope == "global" && !c.RegionClient {
-		sc.Endpoint = fmt.Sprintf("https://%s.%s/", catalog.Name, c.Cloud)
-	} else {
-		sc.Endpoint = fmt.Sprintf("https://%s.%s.%s/", catalog.Name, region, c.Cloud)
-	}
+	sc.Endpoint = c.buildServiceEndpoint(catalog, region)
 
 	sc.ResourceBase = sc.Endpoint
 	if catalog.Version != "" {
@@ -315,7 +411,7 @@ func (c *Config) newServiceClientByName(client *golangsdk.ProviderClient, catalo
 // newServiceClientByEndpoint returns a ServiceClient which the endpoint was initialized by customer
 // the format of customer endpoint likes https://{Name}.{Region}.xxxx.com
 func (c *Config) newServiceClientByEndpoint(client *golangsdk.ProviderClient, srv, endpoint string) (*golangsdk.ServiceClient, error) {
-	catalog, ok := allServiceCatalog[srv]
+	catalog, ok := c.getServiceCatalog(srv)
 	if !ok {
 		return nil, fmt.Errorf("service type %s is invalid or not supportted", srv)
 	}
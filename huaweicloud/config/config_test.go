@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/chnsz/golangsdk"
 	th "github.com/chnsz/golangsdk/testhelper"
@@ -80,3 +81,45 @@ func TestCheckObsEndpoint(t *testing.T) {
 	expected = "https://oss.region-1.myhuaweicloud.com/"
 	th.AssertEquals(t, expected, getObsEndpoint(cfg, "region-1"))
 }
+
+func TestGetAuthConfigByProcess(t *testing.T) {
+	cfg := &Config{
+		CredentialProcess: `echo '{"access":"test-ak","secret":"test-sk","securitytoken":"test-token",` +
+			`"expires_at":"2023-06-01T08:00:00.000000Z"}'`,
+	}
+
+	th.AssertNoErr(t, getAuthConfigByProcess(cfg))
+	th.AssertEquals(t, "test-ak", cfg.AccessKey)
+	th.AssertEquals(t, "test-sk", cfg.SecretKey)
+	th.AssertEquals(t, "test-token", cfg.SecurityToken)
+	th.AssertEquals(t, int64(1685606400), cfg.SecurityKeyExpiresAt.Unix())
+
+	cfg.CredentialProcess = `echo '{"access":"test-ak"}'`
+	th.AssertEquals(t, true, getAuthConfigByProcess(cfg) != nil)
+}
+
+func TestBuildAuthCredentialsReloadsProcessCredentials(t *testing.T) {
+	cfg := &Config{
+		CredentialProcess: `echo '{"access":"new-ak","secret":"new-sk","securitytoken":"new-token",` +
+			`"expires_at":"2099-01-01T00:00:00Z"}'`,
+		AccessKey:            "expired-ak",
+		SecretKey:            "expired-sk",
+		SecurityToken:        "expired-token",
+		SecurityKeyExpiresAt: time.Now().Add(-time.Minute),
+		IdentityEndpoint:     "https://iam.cn-north-4.myhuaweicloud.com/v3",
+		Region:               "cn-north-4",
+		TenantID:             "test-project",
+		RegionProjectIDMap:   map[string]string{"cn-north-4": "test-project"},
+		RPLock:               new(sync.Mutex),
+		SecurityKeyLock:      new(sync.Mutex),
+	}
+
+	// the expired credentials of the credential process should be reloaded before building the sdk-v3 credentials
+	credentials, err := buildAuthCredentials(cfg, "cn-north-4")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "new-ak", credentials.AK)
+	th.AssertEquals(t, "new-sk", credentials.SK)
+	th.AssertEquals(t, "new-token", credentials.SecurityToken)
+	th.AssertEquals(t, int64(4070908800), cfg.SecurityKeyExpiresAt.Unix())
+	th.AssertEquals(t, "new-ak", cfg.HwClient.AKSKAuthOptions.AccessKey)
+}
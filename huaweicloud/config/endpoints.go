@@ -1,9 +1,5 @@
 package config
 
-import (
-	"fmt"
-)
-
 // ServiceCatalog defines a struct which was used to generate a service client for huaweicloud.
 // the endpoint likes https://{Name}.{Region}.myhuaweicloud.com/{Version}/{project_id}/{ResourceBase}
 // For more information, please refer to Config.NewServiceClient
@@ -15,6 +11,8 @@ type ServiceCatalog struct {
 	ResourceBase     string
 	WithOutProjectID bool
 	Product          string
+	// HostTemplate is used to override the default endpoint pattern, which is only set by the service catalog file
+	HostTemplate string
 }
 
 // multiCatalogKeys is a map of primary and derived catalog keys for services with multiple clients.
@@ -774,19 +772,13 @@ func GetServiceEndpoint(c *Config, srv, region string) string {
 		return endpoint
 	}
 
-	// get the endpoint from build-in catalog
-	catalog, ok := allServiceCatalog[srv]
+	// get the endpoint from build-in catalog or the service catalog file
+	catalog, ok := c.getServiceCatalog(srv)
 	if !ok {
 		return ""
 	}
 
-	var ep string
-	if catalog.Scope == "global" && !c.RegionClient {
-		ep = fmt.Sprintf("https://%s.%s/", catalog.Name, c.Cloud)
-	} else {
-		ep = fmt.Sprintf("https://%s.%s.%s/", catalog.Name, region, c.Cloud)
-	}
-	return ep
+	return c.buildServiceEndpoint(catalog, region)
 }
 
 // GetServiceCatalog returns the catalog object of a service
@@ -42,14 +42,18 @@ This file is used to impl the configuration of huaweicloud-sdk-go-v3 package and
 genetate service clients.
 */
 func buildAuthCredentials(c *Config, region string) (*basic.Credentials, error) {
-	if c.AccessKey == "" || c.SecretKey == "" {
+	ak, sk, securityToken, err := c.getSecurityKey()
+	if err != nil {
+		return nil, err
+	}
+	if ak == "" || sk == "" {
 		return nil, fmt.Errorf("access_key or secret_key is missing in the provider")
 	}
 
 	credentials := basic.Credentials{
-		AK:            c.AccessKey,
-		SK:            c.SecretKey,
-		SecurityToken: c.SecurityToken,
+		AK:            ak,
+		SK:            sk,
+		SecurityToken: securityToken,
 		IamEndpoint:   c.IdentityEndpoint,
 	}
 
@@ -71,22 +75,26 @@ func buildAuthCredentials(c *Config, region string) (*basic.Credentials, error)
 }
 
 func buildGlobalAuthCredentials(c *Config, region string) (*global.Credentials, error) {
-	if c.AccessKey == "" || c.SecretKey == "" {
+	ak, sk, securityToken, err := c.getSecurityKey()
+	if err != nil {
+		return nil, err
+	}
+	if ak == "" || sk == "" {
 		return nil, fmt.Errorf("access_key or secret_key is missing in the provider")
 	}
 
 	credentials := global.Credentials{
-		AK:            c.AccessKey,
-		SK:            c.SecretKey,
+		AK:            ak,
+		SK:            sk,
 		DomainId:      c.DomainID,
-		SecurityToken: c.SecurityToken,
+		SecurityToken: securityToken,
 		IamEndpoint:   c.IdentityEndpoint,
 	}
 
 	return &credentials, nil
 }
 
-func buildHTTPConfig(c *Config) *hcconfig.HttpConfig {
+func buildHTTPConfig(c *Config, product string) *hcconfig.HttpConfig {
 	httpConfig := hcconfig.DefaultHttpConfig()
 
 	if c.MaxRetries > 0 {
@@ -100,8 +108,28 @@ func buildHTTPConfig(c *Config) *hcconfig.HttpConfig {
 	httpHandler := httphandler.NewHttpHandler().
 		AddRequestHandler(logRequestHandler).
 		AddResponseHandler(logResponseHandler)
+	if limiter, ok := c.RateLimiters[product]; ok {
+		httpHandler.AddRequestHandler(func(request http.Request) {
+			if err := limiter.Wait(request.Context()); err != nil {
+				log.Printf("[WARN] failed to wait for the rate limiter: %s", err)
+			}
+			logRequestHandler(request)
+		}).AddResponseHandler(func(response http.Response) {
+			limiter.OnResponse(response.StatusCode)
+			logResponseHandler(response)
+		})
+	}
+	if IsAPITraceEnabled() {
+		httpHandler.AddMonitorHandler(c.traceMonitorHandler())
+	}
 	httpConfig = httpConfig.WithHttpHandler(httpHandler)
 
+	if vcr := getCassette(); vcr != nil {
+		// the local server of the cassette uses a self-signed certificate, and it sends the requests to the cloud
+		// with the proxy and the verification of the provider
+		return httpConfig.WithIgnoreSSLVerification(true).WithDialContext(vcr.dialContext(c.Insecure))
+	}
+
 	if proxyURL := getProxyFromEnv(); proxyURL != "" {
 		if parsed, err := url.Parse(proxyURL); err == nil {
 			logp.Printf("[DEBUG] using https proxy: %s://%s", parsed.Scheme, parsed.Host)
@@ -294,7 +322,7 @@ func NewHcClient(c *Config, region, product string, globalFlag bool) (*core.HcHt
 		return nil, fmt.Errorf("failed to get the endpoint of %q service in region %s", product, region)
 	}
 
-	builder := core.NewHcHttpClientBuilder().WithEndpoint(endpoint).WithHttpConfig(buildHTTPConfig(c))
+	builder := core.NewHcHttpClientBuilder().WithEndpoint(endpoint).WithHttpConfig(buildHTTPConfig(c, product))
 
 	if globalFlag {
 		credentials, err := buildGlobalAuthCredentials(c, region)
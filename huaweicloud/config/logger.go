@@ -25,6 +25,11 @@ var maxTimeout = 10 * time.Minute
 type LogRoundTripper struct {
 	Rt         http.RoundTripper
 	MaxRetries int
+	// RateLimiter is used to limit the rate of the requests, including the retries of the connection errors
+	RateLimiter *RateLimiter
+	// ResourceType and Operation are written into the API trace records
+	ResourceType string
+	Operation    string
 }
 
 func retryTimeout(count int) time.Duration {
@@ -48,6 +53,8 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 	//tlsconfig := lrt.Rt.(*http.Transport).TLSClientConfig
 
 	var err error
+	var requestBody []byte
+	start := time.Now()
 
 	log.Printf("[DEBUG] API Request URL: %s %s", request.Method, request.URL)
 	log.Printf("[DEBUG] API Request Headers:\n%s", FormatHeaders(request.Header, "\n"))
@@ -59,10 +66,23 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 		}
 	}
 
-	response, err := lrt.Rt.RoundTrip(request)
+	vcr := getCassette()
+	if vcr != nil && request.Body != nil {
+		requestBody, err = io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		request.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	if vcr != nil && vcr.Mode == VcrModeReplay {
+		return vcr.replay(request, requestBody)
+	}
+
+	response, err := lrt.roundTrip(request)
 	if response == nil {
 		errMessage := err.Error()
 		if strings.Contains(errMessage, "no such host") {
+			lrt.traceRequest(request, nil, start, 0, err)
 			return nil, err
 		}
 	}
@@ -74,6 +94,7 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 		if retry > lrt.MaxRetries {
 			log.Printf("[DEBUG] connection error, retries exhausted. Aborting")
 			err = fmt.Errorf("connection error, retries exhausted. Aborting. Last error was: %s", err)
+			lrt.traceRequest(request, nil, start, retry-1, err)
 			return nil, err
 		}
 
@@ -81,15 +102,41 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 
 		//lintignore:R018
 		time.Sleep(retryTimeout(retry))
-		response, err = lrt.Rt.RoundTrip(request)
+		response, err = lrt.roundTrip(request)
 		retry++
 	}
 
 	log.Printf("[DEBUG] API Response Code: %d", response.StatusCode)
 	log.Printf("[DEBUG] API Response Headers:\n%s", FormatHeaders(response.Header, "\n"))
 
+	if vcr != nil {
+		response.Body, err = vcr.record(request, requestBody, response)
+		if err != nil {
+			return nil, fmt.Errorf("error recording the interaction into the cassette file: %s", err)
+		}
+	}
+
 	response.Body, err = lrt.logResponse(response.Body, response.Header.Get("Content-Type"))
 
+	lrt.traceRequest(request, response, start, retry-1, err)
+
+	return response, err
+}
+
+// roundTrip sends the request after getting a token from the rate limiter (if configured),
+// and adapts the rate limiter according to the response.
+func (lrt *LogRoundTripper) roundTrip(request *http.Request) (*http.Response, error) {
+	if lrt.RateLimiter == nil {
+		return lrt.Rt.RoundTrip(request)
+	}
+
+	if err := lrt.RateLimiter.Wait(request.Context()); err != nil {
+		return nil, err
+	}
+	response, err := lrt.Rt.RoundTrip(request)
+	if response != nil {
+		lrt.RateLimiter.OnResponse(response.StatusCode)
+	}
 	return response, err
 }
 
@@ -201,19 +248,24 @@ func FormatHeaders(headers http.Header, seperator string) string {
 }
 
 func maskSecurityFields(data map[string]interface{}) {
+	maskFields(data, true)
+}
+
+// maskFields masks the security fields, and the large string fields will be masked if skipLarge is true.
+func maskFields(data map[string]interface{}, skipLarge bool) {
 	for k, val := range data {
 		switch val := val.(type) {
 		case string:
 			if isSecurityFields(k) {
 				data[k] = "***"
-			} else if len(val) > MAXFieldLength {
+			} else if skipLarge && len(val) > MAXFieldLength {
 				data[k] = "** large string **"
 			}
 		case map[string]interface{}:
 			if isSecurityFields(k) {
 				data[k] = map[string]string{"***": "***"}
 			} else {
-				maskSecurityFields(val)
+				maskFields(val, skipLarge)
 			}
 		}
 	}
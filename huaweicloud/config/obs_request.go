@@ -0,0 +1,122 @@
+package config
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+)
+
+// obsSignedSubResources are the sub-resources of the bucket requests which are included in the canonicalized resource
+// of the OBS signature, the other query parameters (such as the inventory ID) are not signed.
+var obsSignedSubResources = map[string]bool{
+	"notification":       true,
+	"object-lock":        true,
+	"inventory":          true,
+	"mirrorBackToSource": true,
+}
+
+// ObjectStorageBucketRequest sends a request of the bucket sub-resources which are not supported by the OBS SDK, such
+// as object-lock, inventory and mirrorBackToSource. The request is signed with the OBS signature, and the response
+// body is returned. An obs.ObsError is returned if OBS responds with an error status code.
+func (c *Config) ObjectStorageBucketRequest(region, method, bucket string, subResources map[string]string,
+	contentType string, body []byte) ([]byte, error) {
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return nil, fmt.Errorf("missing credentials for OBS, need access_key and secret_key values for provider")
+	}
+	if err := c.reloadSecurityKeyIfExpiring(); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := url.Parse(getObsEndpoint(c, region))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OBS endpoint: %s", err)
+	}
+
+	// the sub-resources are sorted in the canonicalized resource
+	keys := make([]string, 0, len(subResources))
+	for k := range subResources {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	params := make([]string, 0, len(keys))
+	queries := make([]string, len(keys))
+	for i, k := range keys {
+		param, query := k, url.QueryEscape(k)
+		if v := subResources[k]; v != "" {
+			param += "=" + v
+			query += "=" + url.QueryEscape(v)
+		}
+		queries[i] = query
+		if obsSignedSubResources[k] {
+			params = append(params, param)
+		}
+	}
+	canonicalizedResource := fmt.Sprintf("/%s/?%s", bucket, strings.Join(params, "&"))
+	requestURL := fmt.Sprintf("%s://%s.%s/?%s", endpoint.Scheme, bucket, endpoint.Host, strings.Join(queries, "&"))
+
+	req, err := http.NewRequest(method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var contentMD5 string
+	if len(body) > 0 {
+		sum := md5.Sum(body)
+		contentMD5 = base64.StdEncoding.EncodeToString(sum[:])
+		req.Header.Set("Content-MD5", contentMD5)
+		req.Header.Set("Content-Type", contentType)
+	} else {
+		contentType = ""
+	}
+	date := time.Now().UTC().Format(http.TimeFormat)
+	req.Header.Set("Date", date)
+	req.Header.Set("User-Agent", buildObsUserAgent())
+
+	var canonicalizedHeaders string
+	if c.SecurityToken != "" {
+		req.Header.Set("x-obs-security-token", c.SecurityToken)
+		canonicalizedHeaders = "x-obs-security-token:" + c.SecurityToken + "\n"
+	}
+
+	stringToSign := strings.Join([]string{method, contentMD5, contentType, date, canonicalizedHeaders}, "\n") +
+		canonicalizedResource
+	signature := base64.StdEncoding.EncodeToString(obs.HmacSha1([]byte(c.SecretKey), []byte(stringToSign)))
+	req.Header.Set("Authorization", fmt.Sprintf("OBS %s:%s", c.AccessKey, signature))
+
+	resp, err := c.DomainClient.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		obsError := obs.ObsError{
+			Status: resp.Status,
+		}
+		obsError.StatusCode = resp.StatusCode
+		obsError.RequestId = resp.Header.Get("x-obs-request-id")
+		obsError.Code = resp.Header.Get("x-obs-error-code")
+		obsError.Message = resp.Header.Get("x-obs-error-message")
+		// the error details are returned in the XML body, or in the JSON body for the JSON APIs
+		if len(respBody) > 0 && xml.Unmarshal(respBody, &obsError) != nil {
+			_ = json.Unmarshal(respBody, &obsError)
+		}
+		return nil, obsError
+	}
+	return respBody, nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/obs"
+	th "github.com/chnsz/golangsdk/testhelper"
+)
+
+type obsRoundTripper struct {
+	request *http.Request
+	status  int
+	body    string
+}
+
+func (rt *obsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.request = req
+	return &http.Response{
+		StatusCode: rt.status,
+		Status:     http.StatusText(rt.status),
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(rt.body)),
+	}, nil
+}
+
+func TestObjectStorageBucketRequest(t *testing.T) {
+	rt := &obsRoundTripper{status: http.StatusOK, body: "<ObjectLockConfiguration/>"}
+	cfg := &Config{
+		AccessKey:    "ak",
+		SecretKey:    "sk",
+		Cloud:        "myhuaweicloud.com",
+		DomainClient: &golangsdk.ProviderClient{HTTPClient: http.Client{Transport: rt}},
+	}
+
+	body, err := cfg.ObjectStorageBucketRequest("cn-north-4", "PUT", "test-bucket",
+		map[string]string{"inventory": "", "id": "report"}, "application/xml", []byte("<xml/>"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "<ObjectLockConfiguration/>", string(body))
+	th.AssertEquals(t, "https://test-bucket.obs.cn-north-4.myhuaweicloud.com/?id=report&inventory",
+		rt.request.URL.String())
+	// the inventory ID is not a signed sub-resource
+	stringToSign := strings.Join([]string{"PUT", rt.request.Header.Get("Content-MD5"), "application/xml",
+		rt.request.Header.Get("Date"), ""}, "\n") + "/test-bucket/?inventory"
+	signature := base64.StdEncoding.EncodeToString(obs.HmacSha1([]byte("sk"), []byte(stringToSign)))
+	th.AssertEquals(t, "OBS ak:"+signature, rt.request.Header.Get("Authorization"))
+	th.AssertEquals(t, "application/xml", rt.request.Header.Get("Content-Type"))
+	th.AssertEquals(t, true, rt.request.Header.Get("Content-MD5") != "")
+
+	rt.status = http.StatusNotFound
+	rt.body = `<Error><Code>NoSuchInventoryConfiguration</Code><Message>not found</Message></Error>`
+	_, err = cfg.ObjectStorageBucketRequest("cn-north-4", "GET", "test-bucket",
+		map[string]string{"inventory": "", "id": "report"}, "", nil)
+	obsError, ok := err.(obs.ObsError)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 404, obsError.StatusCode)
+	th.AssertEquals(t, "NoSuchInventoryConfiguration", obsError.Code)
+
+	rt.body = `{"code":"NoSuchMirrorConfiguration","message":"not found"}`
+	_, err = cfg.ObjectStorageBucketRequest("cn-north-4", "GET", "test-bucket",
+		map[string]string{"mirrorBackToSource": ""}, "", nil)
+	obsError, ok = err.(obs.ObsError)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "NoSuchMirrorConfiguration", obsError.Code)
+}
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// preflightEndpointTimeout is the timeout of checking the reachability of each custom endpoint.
+const preflightEndpointTimeout = 10 * time.Second
+
+// PreflightError is a problem found by the preflight checks.
+type PreflightError struct {
+	// Summary is a short description of the problem
+	Summary string
+	// Detail is the detailed information and the suggestion to fix the problem
+	Detail string
+}
+
+func (e PreflightError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Summary, e.Detail)
+}
+
+type identityRegion struct {
+	ID string `json:"id"`
+}
+
+// RunPreflightChecks checks the credentials, the region, the project ID mapping and the reachability of the custom
+// endpoints, and returns all problems found.
+func (c *Config) RunPreflightChecks() []PreflightError {
+	var problems []PreflightError
+
+	if err := c.checkCredentials(); err != nil {
+		// the region and project checks depend on the IAM APIs, and they will fail with the same reason
+		problems = append(problems, *err)
+	} else {
+		if err := c.checkRegion(); err != nil {
+			problems = append(problems, *err)
+		}
+		if err := c.checkProjectID(); err != nil {
+			problems = append(problems, *err)
+		}
+	}
+	problems = append(problems, c.checkEndpoints()...)
+
+	for _, p := range problems {
+		log.Printf("[WARN] preflight check failed: %s", p.Error())
+	}
+	return problems
+}
+
+// checkCredentials checks whether the credentials are accepted by IAM by querying the domain of the credentials.
+func (c *Config) checkCredentials() *PreflightError {
+	if _, err := c.getDomainID(); err != nil {
+		return &PreflightError{
+			Summary: "invalid credentials",
+			Detail: fmt.Sprintf("the credentials were rejected by IAM (%s), please check the access key, secret key, "+
+				"token or the password of the provider: %s", c.IdentityEndpoint, err),
+		}
+	}
+
+	if c.UserID == "" && c.Username != "" {
+		if _, err := c.getUserIDbyName(c.Username); err != nil {
+			return &PreflightError{
+				Summary: "invalid user name",
+				Detail:  fmt.Sprintf("failed to query the IAM user %s: %s", c.Username, err),
+			}
+		}
+	}
+	return nil
+}
+
+// checkRegion checks whether the region of the provider is in the region list of IAM, and the region list is saved
+// to check the regions of the resources and data sources by CheckResourceRegion.
+func (c *Config) checkRegion() *PreflightError {
+	client, err := c.IdentityV3Client(c.Region)
+	if err != nil {
+		return &PreflightError{
+			Summary: "unable to query regions",
+			Detail:  fmt.Sprintf("error creating IAM client: %s", err),
+		}
+	}
+
+	var resp struct {
+		Regions []identityRegion `json:"regions"`
+	}
+	if _, err := client.Get(client.ServiceURL("regions"), &resp, nil); err != nil {
+		return &PreflightError{
+			Summary: "unable to query regions",
+			Detail:  fmt.Sprintf("failed to list the regions from IAM: %s", err),
+		}
+	}
+
+	regions := make([]string, 0, len(resp.Regions))
+	for _, r := range resp.Regions {
+		regions = append(regions, r.ID)
+	}
+	sort.Strings(regions)
+	c.preflightRegions = regions
+
+	return c.checkRegionAvailable(c.Region)
+}
+
+// CheckResourceRegion checks whether the region of a resource or data source is in the region list of IAM, and
+// whether the project ID of the region can be resolved.
+// It always passes if the preflight checks are disabled, or the region list was not loaded.
+func (c *Config) CheckResourceRegion(region string) error {
+	if region == "" || len(c.preflightRegions) == 0 {
+		return nil
+	}
+	if err := c.checkRegionAvailable(region); err != nil {
+		return fmt.Errorf("preflight check failed: %s", err.Error())
+	}
+	if err := c.checkRegionProjectID(region); err != nil {
+		return fmt.Errorf("preflight check failed: %s", err.Error())
+	}
+	return nil
+}
+
+func (c *Config) checkRegionAvailable(region string) *PreflightError {
+	for _, r := range c.preflightRegions {
+		if r == region {
+			return nil
+		}
+	}
+
+	return &PreflightError{
+		Summary: "invalid region",
+		Detail: fmt.Sprintf("the region %s does not exist, the available regions are: %s", region,
+			strings.Join(c.preflightRegions, ", ")),
+	}
+}
+
+// checkProjectID checks whether the project ID of the provider region can be resolved.
+func (c *Config) checkProjectID() *PreflightError {
+	return c.checkRegionProjectID(c.Region)
+}
+
+// checkRegionProjectID checks whether the project ID of the region can be resolved, the resolved project ID is saved
+// in RegionProjectIDMap.
+func (c *Config) checkRegionProjectID(region string) *PreflightError {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+
+	if _, ok := c.RegionProjectIDMap[region]; ok {
+		return nil
+	}
+	if err := c.loadUserProjects(c.DomainClient, region); err != nil {
+		return &PreflightError{
+			Summary: "unable to resolve project ID",
+			Detail: fmt.Sprintf("the project of region %s was not found or the credentials have no access to it, "+
+				"please check the region and the IAM permissions: %s", region, err),
+		}
+	}
+	return nil
+}
+
+// checkEndpoints checks whether the custom endpoints are reachable, any HTTP response means the endpoint is reachable.
+func (c *Config) checkEndpoints() []PreflightError {
+	if len(c.Endpoints) == 0 {
+		return nil
+	}
+
+	// use the underlying transport to keep the TLS settings of the provider but skip the retries
+	httpClient := &http.Client{}
+	if c.HwClient != nil {
+		httpClient.Transport = c.HwClient.HTTPClient.Transport
+		if lrt, ok := httpClient.Transport.(*LogRoundTripper); ok {
+			httpClient.Transport = lrt.Rt
+		}
+	}
+
+	// the derived catalog keys share the same endpoint, so check each endpoint only once
+	checked := make(map[string]bool)
+	keys := make([]string, 0, len(c.Endpoints))
+	for key := range c.Endpoints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var problems []PreflightError
+	for _, key := range keys {
+		endpoint := c.Endpoints[key]
+		if checked[endpoint] {
+			continue
+		}
+		checked[endpoint] = true
+
+		if err := checkEndpointReachable(httpClient, endpoint); err != nil {
+			problems = append(problems, PreflightError{
+				Summary: "unreachable endpoint",
+				Detail:  fmt.Sprintf("the custom endpoint of %s (%s) is unreachable: %s", key, endpoint, err),
+			})
+		}
+	}
+	return problems
+}
+
+func checkEndpointReachable(client *http.Client, endpoint string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), preflightEndpointTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
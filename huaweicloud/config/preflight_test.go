@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	th "github.com/chnsz/golangsdk/testhelper"
+)
+
+func TestPreflightCheckEndpoints(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// any response means the endpoint is reachable
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	cfg := &Config{
+		Endpoints: map[string]string{
+			"vpc":       th.Endpoint(),
+			"networkv2": th.Endpoint(),
+			"ecs":       "http://127.0.0.1:1/",
+		},
+	}
+
+	problems := cfg.checkEndpoints()
+	th.AssertEquals(t, 1, len(problems))
+	th.AssertEquals(t, "unreachable endpoint", problems[0].Summary)
+	th.AssertEquals(t, true, strings.Contains(problems[0].Detail, "ecs"))
+}
+
+func TestPreflightCheckRegion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/regions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"regions":[{"id":"cn-north-4"},{"id":"ap-southeast-1"},{"id":"la-south-2"}]}`)
+	})
+	th.Mux.HandleFunc("/v3/projects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		// the credentials have no access to the projects of la-south-2
+		if name := r.URL.Query().Get("name"); name != "la-south-2" {
+			_, _ = fmt.Fprintf(w, `{"projects":[{"id":"%s-project","name":"%s"}]}`, name, name)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"projects":[]}`)
+	})
+
+	cfg := &Config{
+		Region:             "cn-north-9",
+		DomainClient:       &golangsdk.ProviderClient{},
+		IdentityEndpoint:   th.Endpoint() + "v3",
+		Endpoints:          map[string]string{"identity": th.Endpoint()},
+		RegionProjectIDMap: make(map[string]string),
+		RPLock:             new(sync.Mutex),
+	}
+
+	problem := cfg.checkRegion()
+	th.AssertEquals(t, true, problem != nil)
+	th.AssertEquals(t, "invalid region", problem.Summary)
+	th.AssertEquals(t, true, strings.Contains(problem.Detail, "ap-southeast-1, cn-north-4"))
+
+	cfg.Region = "cn-north-4"
+	th.AssertEquals(t, true, cfg.checkRegion() == nil)
+
+	// the regions of the resources are checked with the saved region list
+	th.AssertNoErr(t, cfg.CheckResourceRegion("ap-southeast-1"))
+	th.AssertNoErr(t, cfg.CheckResourceRegion(""))
+	th.AssertEquals(t, true, cfg.CheckResourceRegion("cn-north-9") != nil)
+
+	// the project IDs of the resource regions are resolved as well
+	th.AssertEquals(t, "ap-southeast-1-project", cfg.RegionProjectIDMap["ap-southeast-1"])
+	err := cfg.CheckResourceRegion("la-south-2")
+	th.AssertEquals(t, true, err != nil)
+	th.AssertEquals(t, true, strings.Contains(err.Error(), "unable to resolve project ID"))
+}
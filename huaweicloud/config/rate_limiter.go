@@ -0,0 +1,139 @@
+package config
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+)
+
+const (
+	// the minimum rate (requests per second) after the rate limiter is throttled
+	minThrottledRate float64 = 0.5
+	// the ratio of the configured limit which will be recovered after each successful request
+	rateRecoveryRatio float64 = 0.05
+)
+
+// RateLimiter is an adaptive token-bucket limiter shared by all clients of a service catalog.
+// The rate will be halved when a 429 response is received, and then recovered gradually after the successful
+// requests until it reaches the configured limit.
+type RateLimiter struct {
+	// the configured limit, requests per second
+	limit float64
+	// the current rate, requests per second
+	rate   float64
+	tokens float64
+	last   time.Time
+	lock   sync.Mutex
+}
+
+// NewRateLimiter returns a RateLimiter which allows limit requests per second.
+func NewRateLimiter(limit int) *RateLimiter {
+	return &RateLimiter{
+		limit:  float64(limit),
+		rate:   float64(limit),
+		tokens: float64(limit),
+		last:   time.Now(),
+	}
+}
+
+// NewRateLimiters returns the rate limiters of the service catalogs, the derived catalog keys share the same limiter
+// with the primary catalog key.
+func NewRateLimiters(limits map[string]int) map[string]*RateLimiter {
+	limiters := make(map[string]*RateLimiter)
+	for key, limit := range limits {
+		if limit <= 0 {
+			continue
+		}
+
+		limiter := NewRateLimiter(limit)
+		limiters[key] = limiter
+		for _, k := range GetServiceDerivedCatalogKeys(key) {
+			if _, ok := limits[k]; !ok {
+				limiters[k] = limiter
+			}
+		}
+	}
+	return limiters
+}
+
+// refill adds the tokens generated since the last refill, it must be called with the lock held.
+func (l *RateLimiter) refill() {
+	now := time.Now()
+	l.tokens = math.Min(math.Max(l.rate, 1), l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+}
+
+// Wait blocks until a token is available or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.lock.Lock()
+		l.refill()
+		if l.tokens >= 1 {
+			l.tokens--
+			l.lock.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.lock.Unlock()
+
+		if ctx == nil {
+			//lintignore:R018
+			time.Sleep(wait)
+			continue
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// OnResponse adapts the current rate according to the status code of the response.
+func (l *RateLimiter) OnResponse(statusCode int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if statusCode == http.StatusTooManyRequests {
+		l.rate = math.Max(l.rate/2, minThrottledRate)
+		l.tokens = math.Min(l.tokens, 0)
+		log.Printf("[WARN] Received StatusTooManyRequests response code, reduce the rate limit to %.2f/s", l.rate)
+		return
+	}
+
+	if l.rate < l.limit {
+		l.rate = math.Min(l.rate+l.limit*rateRecoveryRatio, l.limit)
+	}
+}
+
+// Rate returns the current rate, requests per second.
+func (l *RateLimiter) Rate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.rate
+}
+
+// rateLimitBackoffFunc is the backoff function used when the rate limits are configured, the rate limiter has already
+// slowed down the requests, so it only waits for seconds rather than minutes before retrying.
+func rateLimitBackoffFunc(ctx context.Context, _ *golangsdk.ErrUnexpectedResponseCode, e error, retries uint) error {
+	sleep := retryTimeout(int(retries))
+	log.Printf("[WARN] Received StatusTooManyRequests response code, try to sleep %s", sleep)
+
+	if ctx != nil {
+		select {
+		case <-time.After(sleep):
+		case <-ctx.Done():
+			return e
+		}
+	} else {
+		//lintignore:R018
+		time.Sleep(sleep)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	th "github.com/chnsz/golangsdk/testhelper"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(10)
+
+	start := time.Now()
+	// the first 10 requests consume the burst tokens, the next 5 requests need to wait about 0.5 second
+	for i := 0; i < 15; i++ {
+		th.AssertNoErr(t, limiter.Wait(context.Background()))
+	}
+	elapsed := time.Since(start)
+	if elapsed < 400*time.Millisecond {
+		t.Fatalf("the requests are not limited, elapsed: %s", elapsed)
+	}
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	limiter := NewRateLimiter(20)
+
+	limiter.OnResponse(http.StatusTooManyRequests)
+	th.AssertEquals(t, float64(10), limiter.Rate())
+	limiter.OnResponse(http.StatusTooManyRequests)
+	th.AssertEquals(t, float64(5), limiter.Rate())
+
+	for i := 0; i < 100; i++ {
+		limiter.OnResponse(http.StatusOK)
+	}
+	th.AssertEquals(t, float64(20), limiter.Rate())
+}
+
+func TestNewRateLimiters(t *testing.T) {
+	limiters := NewRateLimiters(map[string]int{"ecs": 20})
+
+	th.AssertEquals(t, true, limiters["ecs"] != nil)
+	for _, key := range GetServiceDerivedCatalogKeys("ecs") {
+		th.AssertEquals(t, limiters["ecs"], limiters[key])
+	}
+}
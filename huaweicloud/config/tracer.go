@@ -0,0 +1,237 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/httphandler"
+)
+
+/*
+This file is used to impl the structured API tracing, each request will be written into the trace file as a JSON line
+if the HW_TF_API_TRACE_FILE environment variable is set.
+*/
+
+var (
+	// idSegmentRegexp matches the path segments which look like an ID, e.g. the project ID, UUID or numeric ID.
+	idSegmentRegexp = regexp.MustCompile(
+		`^([0-9a-fA-F]{32}|[0-9a-fA-F]{8}(-[0-9a-fA-F]{4}){3}-[0-9a-fA-F]{12}|[0-9]+)$`)
+	// namespaceSegmentRegexp matches the path segments which are neither a collection name nor an ID, e.g. the API
+	// version v2.0 and the extension namespace OS-CREDENTIAL.
+	namespaceSegmentRegexp = regexp.MustCompile(`^(?i:v[0-9]+(\.[0-9]+)?(-ext)?|os-[a-z0-9-]+)$`)
+)
+
+// knownSubPathSegments are the well-known segments which follow a collection name but are not resource IDs.
+var knownSubPathSegments = map[string]bool{
+	"action":             true,
+	"actions":            true,
+	"batch-create":       true,
+	"batch-delete":       true,
+	"batch_create":       true,
+	"batch_delete":       true,
+	"count":              true,
+	"detail":             true,
+	"details":            true,
+	"extend":             true,
+	"filter":             true,
+	"resource_instances": true,
+	"tags":               true,
+}
+
+// APITraceRecord is a record of an API request written into the trace file.
+type APITraceRecord struct {
+	Time         string   `json:"time"`
+	Method       string   `json:"method"`
+	Host         string   `json:"host"`
+	URL          string   `json:"url"`
+	Status       int      `json:"status,omitempty"`
+	LatencyMs    int64    `json:"latency_ms"`
+	Retries      int      `json:"retries"`
+	RequestID    string   `json:"request_id,omitempty"`
+	ResourceType string   `json:"resource_type,omitempty"`
+	Operation    string   `json:"operation,omitempty"`
+	Headers      []string `json:"request_headers,omitempty"`
+	Error        string   `json:"error,omitempty"`
+}
+
+type apiTracer struct {
+	file *os.File
+	lock sync.Mutex
+}
+
+var (
+	tracer     *apiTracer
+	tracerOnce sync.Once
+)
+
+// IsAPITraceEnabled checks whether the API tracing is enabled by the HW_TF_API_TRACE_FILE environment variable.
+func IsAPITraceEnabled() bool {
+	return os.Getenv("HW_TF_API_TRACE_FILE") != ""
+}
+
+// getAPITracer returns the tracer shared by all clients, or nil if the API tracing is not enabled.
+func getAPITracer() *apiTracer {
+	tracerOnce.Do(func() {
+		path := os.Getenv("HW_TF_API_TRACE_FILE")
+		if path == "" {
+			return
+		}
+
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Printf("[WARN] failed to open the API trace file %s: %s", path, err)
+			return
+		}
+		tracer = &apiTracer{file: file}
+	})
+
+	return tracer
+}
+
+func (t *apiTracer) write(record *APITraceRecord) {
+	record.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[WARN] failed to marshal the API trace record: %s", err)
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, err := t.file.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] failed to write the API trace record: %s", err)
+	}
+}
+
+// templateURLPath replaces the ID segments of the URL path with {id} and removes the query,
+// e.g. /v1/{project_id}/servers/{server_id} will be /v1/{id}/servers/{id}.
+// The segments which look like an ID are always replaced. The segment following a collection name, which is a plural
+// noun, is also replaced even though it is a name, e.g. /v2/manage/namespaces/{namespace}/repos/{repository}, unless
+// it is a well-known sub-path such as detail and action.
+func templateURLPath(u *url.URL) string {
+	segments := strings.Split(u.Path, "/")
+	afterCollection := false
+	for i, seg := range segments {
+		switch {
+		case seg == "":
+			continue
+		case idSegmentRegexp.MatchString(seg):
+			segments[i] = "{id}"
+			afterCollection = false
+		case namespaceSegmentRegexp.MatchString(seg):
+			afterCollection = false
+		case afterCollection && !knownSubPathSegments[seg]:
+			segments[i] = "{id}"
+			afterCollection = false
+		default:
+			// the short segments are the service names, e.g. fgs and dms, rather than the collection names
+			afterCollection = len(seg) > 3 && strings.HasSuffix(seg, "s") && !knownSubPathSegments[seg]
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// traceRequest writes the trace record of the request sent by LogRoundTripper.
+// The request and response bodies are not traced, they may carry the credentials and be very large.
+func (lrt *LogRoundTripper) traceRequest(request *http.Request, response *http.Response, start time.Time, retries int,
+	err error) {
+	t := getAPITracer()
+	if t == nil {
+		return
+	}
+
+	record := APITraceRecord{
+		Method:       request.Method,
+		Host:         request.URL.Host,
+		URL:          templateURLPath(request.URL),
+		LatencyMs:    time.Since(start).Milliseconds(),
+		Retries:      retries,
+		ResourceType: lrt.ResourceType,
+		Operation:    lrt.Operation,
+		Headers:      RedactHeaders(request.Header),
+	}
+	if response != nil {
+		record.Status = response.StatusCode
+		record.RequestID = response.Header.Get("X-Request-Id")
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	t.write(&record)
+}
+
+// retryCounter counts the retries of the requests sent by a huaweicloud-sdk-go-v3 client. The SDK reports each
+// attempt as a separate metric without the retry count, so a request which is the same as a previous one answered
+// with a retryable status (429 or 5xx) is counted as a retry of it.
+type retryCounter struct {
+	pending map[string]int
+	lock    sync.Mutex
+}
+
+func (r *retryCounter) count(metric *httphandler.MonitorMetric) int {
+	key := fmt.Sprintf("%s %s%s?%s", metric.Method, metric.Host, metric.Path, metric.Raw)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	retries := r.pending[key]
+	if metric.StatusCode == http.StatusTooManyRequests || metric.StatusCode >= http.StatusInternalServerError {
+		r.pending[key] = retries + 1
+	} else {
+		delete(r.pending, key)
+	}
+	return retries
+}
+
+// traceMonitorHandler returns the monitor handler of huaweicloud-sdk-go-v3 which writes the trace records.
+func (c *Config) traceMonitorHandler() func(*httphandler.MonitorMetric) {
+	retries := retryCounter{pending: make(map[string]int)}
+	return func(metric *httphandler.MonitorMetric) {
+		t := getAPITracer()
+		if t == nil || metric == nil {
+			return
+		}
+
+		record := APITraceRecord{
+			Method:       metric.Method,
+			Host:         metric.Host,
+			URL:          templateURLPath(&url.URL{Path: metric.Path}),
+			Status:       metric.StatusCode,
+			LatencyMs:    metric.Latency.Milliseconds(),
+			Retries:      retries.count(metric),
+			RequestID:    metric.RequestId,
+			ResourceType: c.traceResourceType,
+			Operation:    c.traceOperation,
+		}
+		t.write(&record)
+	}
+}
+
+// WithTraceInfo returns a Config for a Terraform operation, the requests sent by the clients created with it will be
+// traced with the resource type and the operation. The credentials and clients are still owned by the provider
+// Config, they are reloaded there and shared by all operations.
+func (c *Config) WithTraceInfo(resourceType, operation string) *Config {
+	provider := c
+	if c.provider != nil {
+		provider = c.provider
+	}
+	if err := provider.reloadSecurityKeyIfExpiring(); err != nil {
+		log.Printf("[WARN] failed to reload the security key: %s", err)
+	}
+
+	provider.SecurityKeyLock.Lock()
+	clone := *provider
+	provider.SecurityKeyLock.Unlock()
+
+	clone.traceResourceType = resourceType
+	clone.traceOperation = operation
+	clone.provider = provider
+	return &clone
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/httphandler"
+
+	th "github.com/chnsz/golangsdk/testhelper"
+)
+
+func TestTemplateURLPath(t *testing.T) {
+	ecsEndpoint := "https://ecs.cn-north-4.myhuaweicloud.com"
+	projectID := "0970dd7a1300f5672ff2c003c60ae115"
+	serverID := "6a4f7f2c-8a59-4bb6-8b4e-9b5f6ec5e0f4"
+	testCases := map[string]string{
+		ecsEndpoint + "/v1/" + projectID + "/cloudservers/" + serverID + "?limit=10": "/v1/{id}/cloudservers/{id}",
+		"https://iam.myhuaweicloud.com/v3/projects":                                  "/v3/projects",
+		"https://rds.cn-north-4.myhuaweicloud.com/v3/" + projectID + "/jobs/1234":    "/v3/{id}/jobs/{id}",
+		// the names following the collection names are templated
+		"https://swr-api.cn-north-4.myhuaweicloud.com/v2/manage/namespaces/my-org/repos/my-repo/tags": "/v2/manage/" +
+			"namespaces/{id}/repos/{id}/tags",
+		"https://functiongraph.cn-north-4.myhuaweicloud.com/v2/" + projectID + "/fgs/functions/my-func/config": "/v2/" +
+			"{id}/fgs/functions/{id}/config",
+		// the well-known sub-paths, API versions and namespaces are kept
+		ecsEndpoint + "/v1/" + projectID + "/cloudservers/detail":                     "/v1/{id}/cloudservers/detail",
+		ecsEndpoint + "/v2.1/" + projectID + "/servers/" + serverID + "/os-interface": "/v2.1/{id}/servers/{id}/os-interface",
+		"https://vpc.cn-north-4.myhuaweicloud.com/v2.0/" + projectID + "/vpcs/resource_instances/action": "/v2.0/{id}/" +
+			"vpcs/resource_instances/action",
+		"https://iam.myhuaweicloud.com/v3/auth/tokens":                    "/v3/auth/tokens",
+		"https://iam.myhuaweicloud.com/v3.0/OS-CREDENTIAL/securitytokens": "/v3.0/OS-CREDENTIAL/securitytokens",
+	}
+
+	for raw, expected := range testCases {
+		u, err := url.Parse(raw)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, expected, templateURLPath(u))
+	}
+}
+
+func TestWithTraceInfoSharesCredentials(t *testing.T) {
+	provider := &Config{
+		AccessKey:       "old-ak",
+		SecretKey:       "old-sk",
+		SecurityKeyLock: new(sync.Mutex),
+	}
+
+	traced := provider.WithTraceInfo("huaweicloud_vpc", "read")
+	th.AssertEquals(t, "huaweicloud_vpc", traced.traceResourceType)
+	th.AssertEquals(t, "old-ak", traced.AccessKey)
+
+	// the credentials reloaded by the provider Config should be used by the Config carrying the trace information
+	provider.AccessKey, provider.SecretKey = "new-ak", "new-sk"
+	th.AssertNoErr(t, traced.reloadSecurityKeyIfExpiring())
+	th.AssertEquals(t, "new-ak", traced.AccessKey)
+	th.AssertEquals(t, "new-sk", traced.SecretKey)
+
+	// the nested Config should still refer to the provider Config
+	th.AssertEquals(t, provider, traced.WithTraceInfo("huaweicloud_vpc", "update").provider)
+}
+
+func TestWithTraceInfoBuildsHcCredentialsFromProvider(t *testing.T) {
+	provider := &Config{
+		AccessKey:          "old-ak",
+		SecretKey:          "old-sk",
+		RegionProjectIDMap: map[string]string{"cn-north-4": "test-project"},
+		RPLock:             new(sync.Mutex),
+		SecurityKeyLock:    new(sync.Mutex),
+	}
+	traced := provider.WithTraceInfo("huaweicloud_kms_key", "read")
+
+	// the sdk-v3 credentials of the traced Config should use the keys refreshed by the provider Config
+	provider.AccessKey, provider.SecretKey, provider.SecurityToken = "new-ak", "new-sk", "new-token"
+	credentials, err := buildAuthCredentials(traced, "cn-north-4")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "new-ak", credentials.AK)
+	th.AssertEquals(t, "new-sk", credentials.SK)
+	th.AssertEquals(t, "new-token", credentials.SecurityToken)
+	th.AssertEquals(t, "test-project", credentials.ProjectId)
+
+	globalCredentials, err := buildGlobalAuthCredentials(traced, "cn-north-4")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "new-ak", globalCredentials.AK)
+}
+
+func TestRetryCounter(t *testing.T) {
+	retries := retryCounter{pending: make(map[string]int)}
+	metric := func(path string, status int) *httphandler.MonitorMetric {
+		return &httphandler.MonitorMetric{Method: "GET", Host: "ecs.example.com", Path: path, StatusCode: status}
+	}
+
+	th.AssertEquals(t, 0, retries.count(metric("/v1/servers", 503)))
+	th.AssertEquals(t, 1, retries.count(metric("/v1/servers", 429)))
+	// the other requests are not affected
+	th.AssertEquals(t, 0, retries.count(metric("/v1/volumes", 200)))
+	th.AssertEquals(t, 2, retries.count(metric("/v1/servers", 200)))
+
+	// the polling requests which succeed are not retries
+	th.AssertEquals(t, 0, retries.count(metric("/v1/servers", 200)))
+	th.AssertEquals(t, 0, retries.count(metric("/v1/servers", 200)))
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/aad"
@@ -75,6 +78,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/oms"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/projectman"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rest"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rfs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rms"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/scm"
@@ -91,6 +95,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpn"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/waf"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/workspace"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
 const (
@@ -201,7 +206,6 @@ func Provider() *schema.Provider {
 			"assume_role": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"agency_name": {
@@ -216,6 +220,24 @@ func Provider() *schema.Provider {
 							Description: descriptions["assume_role_domain_name"],
 							DefaultFunc: schema.EnvDefaultFunc("HW_ASSUME_ROLE_DOMAIN_NAME", nil),
 						},
+						"duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  descriptions["assume_role_duration"],
+							ValidateFunc: validation.IntBetween(900, 86400),
+						},
+						"policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  descriptions["assume_role_policy"],
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"policy_ids": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: descriptions["assume_role_policy_ids"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -342,6 +364,20 @@ func Provider() *schema.Provider {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 
+			"service_catalog_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["service_catalog_file"],
+				DefaultFunc: schema.EnvDefaultFunc("HW_SERVICE_CATALOG_FILE", ""),
+			},
+
+			"rate_limits": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: descriptions["rate_limits"],
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+
 			"regional": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -362,6 +398,13 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("HW_PROFILE", ""),
 			},
 
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["credential_process"],
+				DefaultFunc: schema.EnvDefaultFunc("HW_CREDENTIAL_PROCESS", ""),
+			},
+
 			"enterprise_project_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -375,6 +418,51 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 				DefaultFunc: schema.EnvDefaultFunc("HW_MAX_RETRIES", 5),
 			},
+
+			"preflight_checks": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["preflight_checks"],
+				DefaultFunc: schema.EnvDefaultFunc("HW_PREFLIGHT_CHECKS", false),
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: descriptions["default_tags_tags"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: descriptions["ignore_tags_keys"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: descriptions["ignore_tags_key_prefixes"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -394,13 +482,14 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_cbh_instances": cbh.DataSourceCbhInstances(),
 
-			"huaweicloud_cce_addon_template": cce.DataSourceAddonTemplate(),
-			"huaweicloud_cce_cluster":        cce.DataSourceCCEClusterV3(),
-			"huaweicloud_cce_clusters":       cce.DataSourceCCEClusters(),
-			"huaweicloud_cce_node":           cce.DataSourceNode(),
-			"huaweicloud_cce_nodes":          cce.DataSourceNodes(),
-			"huaweicloud_cce_node_pool":      cce.DataSourceCCENodePoolV3(),
-			"huaweicloud_cci_namespaces":     cci.DataSourceCciNamespaces(),
+			"huaweicloud_cce_addon_template":     cce.DataSourceAddonTemplate(),
+			"huaweicloud_cce_cluster":            cce.DataSourceCCEClusterV3(),
+			"huaweicloud_cce_cluster_kubeconfig": cce.DataSourceCCEClusterKubeConfig(),
+			"huaweicloud_cce_clusters":           cce.DataSourceCCEClusters(),
+			"huaweicloud_cce_node":               cce.DataSourceNode(),
+			"huaweicloud_cce_nodes":              cce.DataSourceNodes(),
+			"huaweicloud_cce_node_pool":          cce.DataSourceCCENodePoolV3(),
+			"huaweicloud_cci_namespaces":         cci.DataSourceCciNamespaces(),
 
 			"huaweicloud_cdm_flavors": DataSourceCdmFlavorV1(),
 
@@ -419,6 +508,9 @@ func Provider() *schema.Provider {
 			"huaweicloud_csms_secret_version": dew.DataSourceDewCsmsSecret(),
 			"huaweicloud_css_flavors":         css.DataSourceCssFlavors(),
 
+			"huaweicloud_dc_connections":        dc.DataSourceConnections(),
+			"huaweicloud_dc_virtual_interfaces": dc.DataSourceVirtualInterfaces(),
+
 			"huaweicloud_dcs_flavors":        dcs.DataSourceDcsFlavorsV2(),
 			"huaweicloud_dcs_maintainwindow": dcs.DataSourceDcsMaintainWindow(),
 			"huaweicloud_dcs_instances":      dcs.DataSourceDcsInstance(),
@@ -438,6 +530,8 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_enterprise_project": eps.DataSourceEnterpriseProject(),
 
+			"huaweicloud_er_attachments":  er.DataSourceAttachments(),
+			"huaweicloud_er_instances":    er.DataSourceInstances(),
 			"huaweicloud_er_route_tables": er.DataSourceRouteTables(),
 
 			"huaweicloud_evs_volumes":      evs.DataSourceEvsVolumesV2(),
@@ -512,6 +606,8 @@ func Provider() *schema.Provider {
 			"huaweicloud_rds_backups":         rds.DataSourceBackup(),
 			"huaweicloud_rds_storage_types":   rds.DataSourceStoragetype(),
 
+			"huaweicloud_rest_api": rest.DataSourceRestAPI(),
+
 			"huaweicloud_rms_policy_definitions": rms.DataSourcePolicyDefinitions(),
 
 			"huaweicloud_servicestage_component_runtimes": servicestage.DataSourceComponentRuntimes(),
@@ -540,6 +636,10 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_vpcep_public_services": vpcep.DataSourceVPCEPPublicServices(),
 
+			"huaweicloud_vpn_gateways":                   vpn.DataSourceGateways(),
+			"huaweicloud_vpn_gateway_availability_zones": vpn.DataSourceGatewayAvailabilityZones(),
+			"huaweicloud_vpn_connections":                vpn.DataSourceConnections(),
+
 			"huaweicloud_waf_certificate":         waf.DataSourceWafCertificateV1(),
 			"huaweicloud_waf_policies":            waf.DataSourceWafPoliciesV1(),
 			"huaweicloud_waf_dedicated_instances": waf.DataSourceWafDedicatedInstancesV1(),
@@ -663,17 +763,21 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_cbh_instance": cbh.ResourceCBHInstance(),
 
-			"huaweicloud_cc_connection":       cc.ResourceCloudConnection(),
-			"huaweicloud_cc_network_instance": cc.ResourceNetworkInstance(),
-
-			"huaweicloud_cce_cluster":     cce.ResourceCluster(),
-			"huaweicloud_cce_node":        cce.ResourceNode(),
-			"huaweicloud_cce_node_attach": cce.ResourceCCENodeAttachV3(),
-			"huaweicloud_cce_addon":       cce.ResourceAddon(),
-			"huaweicloud_cce_node_pool":   cce.ResourceCCENodePool(),
-			"huaweicloud_cce_namespace":   cce.ResourceCCENamespaceV1(),
-			"huaweicloud_cce_pvc":         cce.ResourceCcePersistentVolumeClaimsV1(),
-			"huaweicloud_cce_partition":   cce.ResourcePartition(),
+			"huaweicloud_cc_authorization":          cc.ResourceAuthorization(),
+			"huaweicloud_cc_bandwidth_package":      cc.ResourceBandwidthPackage(),
+			"huaweicloud_cc_connection":             cc.ResourceCloudConnection(),
+			"huaweicloud_cc_inter_region_bandwidth": cc.ResourceInterRegionBandwidth(),
+			"huaweicloud_cc_network_instance":       cc.ResourceNetworkInstance(),
+
+			"huaweicloud_cce_cluster":            cce.ResourceCluster(),
+			"huaweicloud_cce_node":               cce.ResourceNode(),
+			"huaweicloud_cce_node_attach":        cce.ResourceCCENodeAttachV3(),
+			"huaweicloud_cce_addon":              cce.ResourceAddon(),
+			"huaweicloud_cce_cluster_log_config": cce.ResourceClusterLogConfig(),
+			"huaweicloud_cce_node_pool":          cce.ResourceCCENodePool(),
+			"huaweicloud_cce_namespace":          cce.ResourceCCENamespaceV1(),
+			"huaweicloud_cce_pvc":                cce.ResourceCcePersistentVolumeClaimsV1(),
+			"huaweicloud_cce_partition":          cce.ResourcePartition(),
 
 			"huaweicloud_cts_tracker":      cts.ResourceCTSTracker(),
 			"huaweicloud_cts_data_tracker": cts.ResourceCTSDataTracker(),
@@ -723,6 +827,7 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_dbss_instance": dbss.ResourceInstance(),
 
+			"huaweicloud_dc_direct_connect":    dc.ResourceDirectConnect(),
 			"huaweicloud_dc_virtual_gateway":   dc.ResourceVirtualGateway(),
 			"huaweicloud_dc_virtual_interface": dc.ResourceVirtualInterface(),
 
@@ -798,11 +903,15 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_enterprise_project": eps.ResourceEnterpriseProject(),
 
-			"huaweicloud_er_association":    er.ResourceAssociation(),
-			"huaweicloud_er_instance":       er.ResourceInstance(),
-			"huaweicloud_er_propagation":    er.ResourcePropagation(),
-			"huaweicloud_er_route_table":    er.ResourceRouteTable(),
-			"huaweicloud_er_vpc_attachment": er.ResourceVpcAttachment(),
+			"huaweicloud_er_association":        er.ResourceAssociation(),
+			"huaweicloud_er_dc_attachment":      er.ResourceDcAttachment(),
+			"huaweicloud_er_instance":           er.ResourceInstance(),
+			"huaweicloud_er_peering_attachment": er.ResourcePeeringAttachment(),
+			"huaweicloud_er_propagation":        er.ResourcePropagation(),
+			"huaweicloud_er_route_table":        er.ResourceRouteTable(),
+			"huaweicloud_er_static_route":       er.ResourceStaticRoute(),
+			"huaweicloud_er_vpc_attachment":     er.ResourceVpcAttachment(),
+			"huaweicloud_er_vpn_attachment":     er.ResourceVpnAttachment(),
 
 			"huaweicloud_evs_snapshot": ResourceEvsSnapshotV2(),
 			"huaweicloud_evs_volume":   evs.ResourceEvsVolume(),
@@ -810,6 +919,9 @@ func Provider() *schema.Provider {
 			"huaweicloud_fgs_async_invoke_configuration": fgs.ResourceAsyncInvokeConfiguration(),
 			"huaweicloud_fgs_dependency":                 fgs.ResourceFgsDependency(),
 			"huaweicloud_fgs_function":                   fgs.ResourceFgsFunctionV2(),
+			"huaweicloud_fgs_function_alias":             fgs.ResourceFunctionAlias(),
+			"huaweicloud_fgs_function_reserved_instance": fgs.ResourceFunctionReservedInstance(),
+			"huaweicloud_fgs_function_version":           fgs.ResourceFunctionVersion(),
 			"huaweicloud_fgs_trigger":                    fgs.ResourceFunctionGraphTrigger(),
 
 			"huaweicloud_ga_accelerator":    ga.ResourceAccelerator(),
@@ -929,23 +1041,37 @@ func Provider() *schema.Provider {
 			"huaweicloud_networking_vip":           vpc.ResourceNetworkingVip(),
 			"huaweicloud_networking_vip_associate": vpc.ResourceNetworkingVIPAssociateV2(),
 
-			"huaweicloud_obs_bucket":             obs.ResourceObsBucket(),
-			"huaweicloud_obs_bucket_acl":         obs.ResourceOBSBucketAcl(),
-			"huaweicloud_obs_bucket_object":      obs.ResourceObsBucketObject(),
-			"huaweicloud_obs_bucket_object_acl":  obs.ResourceOBSBucketObjectAcl(),
-			"huaweicloud_obs_bucket_policy":      obs.ResourceObsBucketPolicy(),
-			"huaweicloud_obs_bucket_replication": obs.ResourceObsBucketReplication(),
+			"huaweicloud_obs_bucket":                       obs.ResourceObsBucket(),
+			"huaweicloud_obs_bucket_acl":                   obs.ResourceOBSBucketAcl(),
+			"huaweicloud_obs_bucket_object":                obs.ResourceObsBucketObject(),
+			"huaweicloud_obs_bucket_object_acl":            obs.ResourceOBSBucketObjectAcl(),
+			"huaweicloud_obs_bucket_objects_sync":          obs.ResourceObsBucketObjectsSync(),
+			"huaweicloud_obs_bucket_policy":                obs.ResourceObsBucketPolicy(),
+			"huaweicloud_obs_bucket_replication":           obs.ResourceObsBucketReplication(),
+			"huaweicloud_obs_bucket_notification":          obs.ResourceObsBucketNotification(),
+			"huaweicloud_obs_bucket_object_lock":           obs.ResourceObsBucketObjectLock(),
+			"huaweicloud_obs_bucket_inventory":             obs.ResourceObsBucketInventory(),
+			"huaweicloud_obs_bucket_mirror_back_to_source": obs.ResourceObsBucketMirrorBackToSource(),
 
 			"huaweicloud_oms_migration_task":       oms.ResourceMigrationTask(),
 			"huaweicloud_oms_migration_task_group": oms.ResourceMigrationTaskGroup(),
 
-			"huaweicloud_rds_mysql_account":            rds.ResourceRdsAccount(),
-			"huaweicloud_rds_mysql_database":           rds.ResourceRdsDatabase(),
-			"huaweicloud_rds_mysql_database_privilege": rds.ResourceRdsDatabasePrivilege(),
-			"huaweicloud_rds_instance":                 rds.ResourceRdsInstance(),
-			"huaweicloud_rds_parametergroup":           rds.ResourceRdsConfiguration(),
-			"huaweicloud_rds_read_replica_instance":    rds.ResourceRdsReadReplicaInstance(),
-			"huaweicloud_rds_backup":                   rds.ResourceBackup(),
+			"huaweicloud_rds_mysql_account":                rds.ResourceRdsAccount(),
+			"huaweicloud_rds_mysql_database":               rds.ResourceRdsDatabase(),
+			"huaweicloud_rds_mysql_database_privilege":     rds.ResourceRdsDatabasePrivilege(),
+			"huaweicloud_rds_pg_account":                   rds.ResourcePgAccount(),
+			"huaweicloud_rds_pg_database":                  rds.ResourcePgDatabase(),
+			"huaweicloud_rds_pg_database_privilege":        rds.ResourcePgDatabasePrivilege(),
+			"huaweicloud_rds_pg_schema":                    rds.ResourcePgSchema(),
+			"huaweicloud_rds_sqlserver_account":            rds.ResourceSQLServerAccount(),
+			"huaweicloud_rds_sqlserver_database":           rds.ResourceSQLServerDatabase(),
+			"huaweicloud_rds_sqlserver_database_privilege": rds.ResourceSQLServerDatabasePrivilege(),
+			"huaweicloud_rds_instance":                     rds.ResourceRdsInstance(),
+			"huaweicloud_rds_parametergroup":               rds.ResourceRdsConfiguration(),
+			"huaweicloud_rds_read_replica_instance":        rds.ResourceRdsReadReplicaInstance(),
+			"huaweicloud_rds_backup":                       rds.ResourceBackup(),
+
+			"huaweicloud_rest_api": rest.ResourceRestAPI(),
 
 			"huaweicloud_rms_policy_assignment":                  rms.ResourcePolicyAssignment(),
 			"huaweicloud_rms_resource_aggregator":                rms.ResourceAggregator(),
@@ -1006,6 +1132,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_vpcep_service":  vpcep.ResourceVPCEndpointService(),
 
 			"huaweicloud_vpn_gateway":                 vpn.ResourceGateway(),
+			"huaweicloud_vpn_gateway_certificate":     vpn.ResourceGatewayCertificate(),
 			"huaweicloud_vpn_customer_gateway":        vpn.ResourceCustomerGateway(),
 			"huaweicloud_vpn_connection":              vpn.ResourceConnection(),
 			"huaweicloud_vpn_connection_health_check": vpn.ResourceConnectionHealthCheck(),
@@ -1205,6 +1332,33 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// check the regions of the resources and data sources with the region list loaded by the preflight checks
+	for _, r := range provider.ResourcesMap {
+		wrapResourceWithRegionCheck(r)
+	}
+	for _, r := range provider.DataSourcesMap {
+		wrapDataSourceWithRegionCheck(r)
+	}
+
+	// merge the provider-level default tags into the tags of every taggable resource and filter out the ignored tags
+	for _, r := range provider.ResourcesMap {
+		wrapResourceWithDefaultTags(r)
+		wrapResourceWithIgnoreTags(r)
+	}
+	for _, r := range provider.DataSourcesMap {
+		wrapDataSourceWithIgnoreTags(r)
+	}
+
+	// trace the API requests with the resource type and Terraform operation
+	if config.IsAPITraceEnabled() {
+		for name, r := range provider.ResourcesMap {
+			wrapResourceWithTraceInfo(name, r)
+		}
+		for name, r := range provider.DataSourcesMap {
+			wrapResourceWithTraceInfo(name, r)
+		}
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1269,19 +1423,43 @@ func init() {
 
 		"assume_role_domain_name": "The name of domain for assume role.",
 
+		"assume_role_duration": "The validity period of the temporary credentials for assume role, in seconds.",
+
+		"assume_role_policy": "The session policy in JSON format to scope down the permissions of the temporary " +
+			"credentials.",
+
+		"assume_role_policy_ids": "The IDs of the system-defined policies to scope down the permissions of the " +
+			"temporary credentials.",
+
 		"cloud": "The endpoint of cloud provider, defaults to myhuaweicloud.com",
 
 		"endpoints": "The custom endpoints used to override the default endpoint URL.",
 
+		"service_catalog_file": "The path to the JSON or YAML file which redefines the service catalogs " +
+			"and the endpoint templates.",
+
 		"regional": "Whether the service endpoints are regional",
 
+		"rate_limits": "The maximum number of requests per second sent to the services.",
+
 		"shared_config_file": "The path to the shared config file. If not set, the default is ~/.hcloud/config.json.",
 
 		"profile": "The profile name as set in the shared config file.",
 
+		"credential_process": "The external command to fetch the credentials in JSON format.",
+
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
 
+		"preflight_checks": "Whether to check the credentials, region, project ID and custom endpoints when the " +
+			"provider is configured.",
+
 		"enterprise_project_id": "enterprise project id",
+
+		"default_tags_tags": "The default tags which will be applied to all taggable resources.",
+
+		"ignore_tags_keys": "The tag keys which will be ignored by all taggable resources.",
+
+		"ignore_tags_key_prefixes": "The tag key prefixes which will be ignored by all taggable resources.",
 	}
 }
 
@@ -1354,20 +1532,17 @@ func configureProvider(_ context.Context, d *schema.ResourceData, terraformVersi
 		MaxRetries:          d.Get("max_retries").(int),
 		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 		SharedConfigFile:    d.Get("shared_config_file").(string),
+		ServiceCatalogFile:  d.Get("service_catalog_file").(string),
 		Profile:             d.Get("profile").(string),
+		CredentialProcess:   d.Get("credential_process").(string),
 		TerraformVersion:    terraformVersion,
 		RegionProjectIDMap:  make(map[string]string),
 		RPLock:              new(sync.Mutex),
 		SecurityKeyLock:     new(sync.Mutex),
 	}
 
-	// get assume role
-	assumeRoleList := d.Get("assume_role").([]interface{})
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleAgency = assumeRole["agency_name"].(string)
-		config.AssumeRoleDomain = assumeRole["domain_name"].(string)
-	}
+	// get assume role, the roles will be assumed in order
+	config.AssumeRoles = buildProviderAssumeRoles(d)
 
 	// get custom endpoints
 	endpoints, err := flattenProviderEndpoints(d)
@@ -1376,13 +1551,456 @@ func configureProvider(_ context.Context, d *schema.ResourceData, terraformVersi
 	}
 	config.Endpoints = endpoints
 
+	// get rate limiters
+	rateLimiters, err := buildProviderRateLimiters(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RateLimiters = rateLimiters
+
+	// get default tags
+	defaultTagsList := d.Get("default_tags").([]interface{})
+	if len(defaultTagsList) == 1 && defaultTagsList[0] != nil {
+		defaultTags := defaultTagsList[0].(map[string]interface{})
+		config.DefaultTags = defaultTags["tags"].(map[string]interface{})
+	}
+
+	// get ignore tags
+	ignoreTagsList := d.Get("ignore_tags").([]interface{})
+	if len(ignoreTagsList) == 1 && ignoreTagsList[0] != nil {
+		ignoreTags := ignoreTagsList[0].(map[string]interface{})
+		config.IgnoreTagKeys = utils.ExpandToStringListBySet(ignoreTags["keys"].(*schema.Set))
+		config.IgnoreTagKeyPrefixes = utils.ExpandToStringListBySet(ignoreTags["key_prefixes"].(*schema.Set))
+	}
+	if err := checkDefaultTagsNotIgnored(&config); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	if err := config.LoadAndValidate(); err != nil {
 		return nil, diag.FromErr(err)
 	}
 
+	if d.Get("preflight_checks").(bool) {
+		if diags := runProviderPreflightChecks(&config); diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	return &config, nil
 }
 
+// runProviderPreflightChecks returns an error diagnostic for each problem found by the preflight checks.
+func runProviderPreflightChecks(conf *config.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, problem := range conf.RunPreflightChecks() {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Preflight check failed: %s", problem.Summary),
+			Detail:   problem.Detail,
+		})
+	}
+	return diags
+}
+
+// checkDefaultTagsNotIgnored checks whether any key of the default tags matches the ignore_tags, such a tag would be
+// filtered out from the state after it is applied, and then be planned again in every plan.
+func checkDefaultTagsNotIgnored(conf *config.Config) error {
+	keys := make([]string, 0, len(conf.DefaultTags))
+	for k := range conf.DefaultTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if utils.IsIgnoredTagKey(k, conf.IgnoreTagKeys, conf.IgnoreTagKeyPrefixes) {
+			return fmt.Errorf("the key %q of default_tags matches the ignore_tags, please remove it from one of them", k)
+		}
+	}
+	return nil
+}
+
+// wrapResourceWithDefaultTags merges the provider-level default tags into the planned tags of the resource, so every
+// taggable resource applies them through its own tags logic, and a change of default_tags shows up in the plan.
+func wrapResourceWithDefaultTags(r *schema.Resource) {
+	if !isTaggableResource(r) {
+		return
+	}
+
+	// SetNew only operates on the computed keys
+	tagsSchema := r.Schema["tags"]
+	tagsSchema.Computed = true
+
+	forceNew := tagsSchema.ForceNew
+	appendCustomizeDiff(r, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return customizeDiffWithDefaultTags(d, meta, forceNew)
+	})
+}
+
+// appendCustomizeDiff runs the function after the CustomizeDiff of the resource.
+func appendCustomizeDiff(r *schema.Resource, f schema.CustomizeDiffFunc) {
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		return f(ctx, d, meta)
+	}
+}
+
+// isTaggableResource checks whether the resource has a configurable tags map.
+func isTaggableResource(r *schema.Resource) bool {
+	tagsSchema, ok := r.Schema["tags"]
+	if !ok || tagsSchema.Type != schema.TypeMap || !tagsSchema.Optional {
+		return false
+	}
+	if elem, ok := tagsSchema.Elem.(*schema.Schema); ok && elem.Type != schema.TypeString {
+		return false
+	}
+	return true
+}
+
+// customizeDiffWithDefaultTags plans the tags as the configured tags merged with the default tags of the provider.
+func customizeDiffWithDefaultTags(d *schema.ResourceDiff, meta interface{}, forceNew bool) error {
+	conf, ok := meta.(*config.Config)
+	if !ok {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	rawTags := rawConfig.GetAttr("tags")
+	if !rawTags.IsWhollyKnown() {
+		return d.SetNewComputed("tags")
+	}
+
+	tagmap := make(map[string]interface{})
+	if !rawTags.IsNull() {
+		for k, v := range rawTags.AsValueMap() {
+			if !v.IsNull() {
+				tagmap[k] = v.AsString()
+			}
+		}
+	}
+
+	defaultTags := conf.DefaultTags
+	if forceNew && d.Id() != "" {
+		// changing the tags will rebuild the resource, so only keep the default tags which have been applied
+		oldTags, _ := d.GetChange("tags")
+		defaultTags = make(map[string]interface{})
+		for k, v := range oldTags.(map[string]interface{}) {
+			if _, ok := conf.DefaultTags[k]; ok {
+				defaultTags[k] = v
+			}
+		}
+	}
+	return d.SetNew("tags", utils.MergeDefaultTags(defaultTags, tagmap))
+}
+
+// wrapResourceWithIgnoreTags wraps the create, read and update functions of the resource, the tags matching the
+// provider-level ignore_tags are filtered out before they are saved to the state. The ignored tag keys can not be set
+// in the tags, because they are filtered out when the resource is refreshed without the configuration.
+func wrapResourceWithIgnoreTags(r *schema.Resource) {
+	if !isTaggableResource(r) {
+		return
+	}
+
+	appendCustomizeDiff(r, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		conf, ok := meta.(*config.Config)
+		if !ok {
+			return nil
+		}
+		for _, k := range getConfiguredTagKeys(d.GetRawConfig()) {
+			if utils.IsIgnoredTagKey(k, conf.IgnoreTagKeys, conf.IgnoreTagKeyPrefixes) {
+				return fmt.Errorf("the tag key %q matches the ignore_tags of the provider, it can not be set in tags", k)
+			}
+		}
+		return nil
+	})
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			defer filterIgnoredTags(d, meta, r.Schema)
+			return f(ctx, d, meta)
+		}
+	}
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			defer filterIgnoredTags(d, meta, r.Schema)
+			return f(ctx, d, meta)
+		}
+	}
+	//nolint:staticcheck
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			defer filterIgnoredTags(d, meta, r.Schema)
+			return f(d, meta)
+		}
+	}
+	//nolint:staticcheck
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			defer filterIgnoredTags(d, meta, r.Schema)
+			return f(d, meta)
+		}
+	}
+	wrapReadWithIgnoreTags(r)
+}
+
+// wrapDataSourceWithIgnoreTags wraps the read function of the data source, the tags matching the provider-level
+// ignore_tags are filtered out before they are saved to the state.
+func wrapDataSourceWithIgnoreTags(r *schema.Resource) {
+	for _, s := range r.Schema {
+		if isTagsSchema(s) || getNestedTagsSchema(s) != nil {
+			wrapReadWithIgnoreTags(r)
+			return
+		}
+	}
+}
+
+func wrapReadWithIgnoreTags(r *schema.Resource) {
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			defer filterIgnoredTags(d, meta, r.Schema)
+			return f(ctx, d, meta)
+		}
+	}
+	//nolint:staticcheck
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			defer filterIgnoredTags(d, meta, r.Schema)
+			return f(d, meta)
+		}
+	}
+}
+
+// isTagsSchema checks whether the schema is a map of string tags.
+func isTagsSchema(s *schema.Schema) bool {
+	if s.Type != schema.TypeMap {
+		return false
+	}
+	elem, ok := s.Elem.(*schema.Schema)
+	return !ok || elem.Type == schema.TypeString
+}
+
+// getNestedTagsSchema returns the element schema of a computed list or set whose elements have the tags.
+func getNestedTagsSchema(s *schema.Schema) *schema.Resource {
+	if (s.Type != schema.TypeList && s.Type != schema.TypeSet) || !s.Computed || s.Optional || s.Required {
+		return nil
+	}
+	elem, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		return nil
+	}
+	if tagsSchema, ok := elem.Schema["tags"]; ok && isTagsSchema(tagsSchema) {
+		return elem
+	}
+	return nil
+}
+
+// getConfiguredTagKeys returns the keys of the tags in the configuration, the configuration is null when the resource
+// is refreshed.
+func getConfiguredTagKeys(rawConfig cty.Value) []string {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() ||
+		!rawConfig.Type().HasAttribute("tags") {
+		return nil
+	}
+	rawTags := rawConfig.GetAttr("tags")
+	if rawTags.IsNull() || !rawTags.IsKnown() || !rawTags.CanIterateElements() {
+		return nil
+	}
+
+	keys := make([]string, 0, rawTags.LengthInt())
+	for k := range rawTags.AsValueMap() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// filterIgnoredTags removes the ignored tags from the state, including the tags of the elements of the computed lists.
+// The keys which are set in the tags of the configuration, such as the tags filter of the data sources, are kept.
+func filterIgnoredTags(d *schema.ResourceData, meta interface{}, s map[string]*schema.Schema) {
+	conf, ok := meta.(*config.Config)
+	if !ok || d.Id() == "" || (len(conf.IgnoreTagKeys) == 0 && len(conf.IgnoreTagKeyPrefixes) == 0) {
+		return
+	}
+
+	isIgnored := func(k string) bool {
+		return utils.IsIgnoredTagKey(k, conf.IgnoreTagKeys, conf.IgnoreTagKeyPrefixes)
+	}
+
+	if tagsSchema, ok := s["tags"]; ok && isTagsSchema(tagsSchema) {
+		configured := make(map[string]bool)
+		for _, k := range getConfiguredTagKeys(d.GetRawConfig()) {
+			configured[k] = true
+		}
+		tagmap := d.Get("tags").(map[string]interface{})
+		if result, changed := removeIgnoredTags(tagmap, func(k string) bool {
+			return !configured[k] && isIgnored(k)
+		}); changed {
+			if err := d.Set("tags", result); err != nil {
+				log.Printf("[WARN] error filtering out the ignored tags of (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	for key, attrSchema := range s {
+		if getNestedTagsSchema(attrSchema) == nil {
+			continue
+		}
+
+		var elems []interface{}
+		switch v := d.Get(key).(type) {
+		case []interface{}:
+			elems = v
+		case *schema.Set:
+			elems = v.List()
+		}
+
+		var changed bool
+		for _, elem := range elems {
+			elemMap, ok := elem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			tagmap, _ := elemMap["tags"].(map[string]interface{})
+			if result, ok := removeIgnoredTags(tagmap, isIgnored); ok {
+				elemMap["tags"] = result
+				changed = true
+			}
+		}
+		if changed {
+			if err := d.Set(key, elems); err != nil {
+				log.Printf("[WARN] error filtering out the ignored tags of %s (%s): %s", key, d.Id(), err)
+			}
+		}
+	}
+}
+
+// removeIgnoredTags returns the tags without the ignored keys, and whether any key is removed.
+func removeIgnoredTags(tagmap map[string]interface{}, isIgnored func(string) bool) (map[string]interface{}, bool) {
+	result := make(map[string]interface{}, len(tagmap))
+	for k, v := range tagmap {
+		if !isIgnored(k) {
+			result[k] = v
+		}
+	}
+	return result, len(result) != len(tagmap)
+}
+
+// wrapResourceWithRegionCheck checks the region argument and its project ID of the resource when planning.
+func wrapResourceWithRegionCheck(r *schema.Resource) {
+	if !hasRegionArgument(r) {
+		return
+	}
+
+	appendCustomizeDiff(r, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		conf, ok := meta.(*config.Config)
+		if !ok || !d.NewValueKnown("region") {
+			return nil
+		}
+		return conf.CheckResourceRegion(d.Get("region").(string))
+	})
+}
+
+// hasRegionArgument checks whether the region of the resource or data source can be specified.
+func hasRegionArgument(r *schema.Resource) bool {
+	regionSchema, ok := r.Schema["region"]
+	return ok && regionSchema.Type == schema.TypeString && (regionSchema.Optional || regionSchema.Required)
+}
+
+// wrapDataSourceWithRegionCheck checks the region argument and its project ID of the data source before reading it.
+func wrapDataSourceWithRegionCheck(r *schema.Resource) {
+	if !hasRegionArgument(r) {
+		return
+	}
+
+	checkRegion := func(d *schema.ResourceData, meta interface{}) error {
+		if conf, ok := meta.(*config.Config); ok {
+			return conf.CheckResourceRegion(d.Get("region").(string))
+		}
+		return nil
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := checkRegion(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, meta)
+		}
+	}
+	//nolint:staticcheck
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkRegion(d, meta); err != nil {
+				return err
+			}
+			return f(d, meta)
+		}
+	}
+}
+
+// wrapResourceWithTraceInfo wraps the CRUD functions of the resource, the meta passed to the functions will carry the
+// resource type and Terraform operation which are written into the API trace records.
+func wrapResourceWithTraceInfo(resourceType string, r *schema.Resource) {
+	withTraceInfo := func(meta interface{}, operation string) interface{} {
+		if conf, ok := meta.(*config.Config); ok {
+			return conf.WithTraceInfo(resourceType, operation)
+		}
+		return meta
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, withTraceInfo(meta, "create"))
+		}
+	}
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, withTraceInfo(meta, "read"))
+		}
+	}
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, withTraceInfo(meta, "update"))
+		}
+	}
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, withTraceInfo(meta, "delete"))
+		}
+	}
+
+	//nolint:staticcheck
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, withTraceInfo(meta, "create"))
+		}
+	}
+	//nolint:staticcheck
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, withTraceInfo(meta, "read"))
+		}
+	}
+	//nolint:staticcheck
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, withTraceInfo(meta, "update"))
+		}
+	}
+	//nolint:staticcheck
+	if f := r.Delete; f != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, withTraceInfo(meta, "delete"))
+		}
+	}
+}
+
 func flattenProviderEndpoints(d *schema.ResourceData) (map[string]string, error) {
 	endpoints := d.Get("endpoints").(map[string]interface{})
 	epMap := make(map[string]string)
@@ -1421,6 +2039,39 @@ func flattenProviderEndpoints(d *schema.ResourceData) (map[string]string, error)
 	return epMap, nil
 }
 
+func buildProviderAssumeRoles(d *schema.ResourceData) []config.AssumeRole {
+	assumeRoleList := d.Get("assume_role").([]interface{})
+	assumeRoles := make([]config.AssumeRole, 0, len(assumeRoleList))
+
+	for _, v := range assumeRoleList {
+		assumeRole := v.(map[string]interface{})
+		assumeRoles = append(assumeRoles, config.AssumeRole{
+			AgencyName: assumeRole["agency_name"].(string),
+			DomainName: assumeRole["domain_name"].(string),
+			Duration:   assumeRole["duration"].(int),
+			Policy:     assumeRole["policy"].(string),
+			PolicyIDs:  utils.ExpandToStringList(assumeRole["policy_ids"].([]interface{})),
+		})
+	}
+	return assumeRoles
+}
+
+func buildProviderRateLimiters(d *schema.ResourceData) (map[string]*config.RateLimiter, error) {
+	rateLimits := d.Get("rate_limits").(map[string]interface{})
+	limitMap := make(map[string]int)
+
+	for key, val := range rateLimits {
+		limit := val.(int)
+		if limit <= 0 {
+			return nil, fmt.Errorf("the rate limit of service %s must be a positive value", key)
+		}
+		limitMap[key] = limit
+	}
+
+	log.Printf("[DEBUG] rate limits: %+v", limitMap)
+	return config.NewRateLimiters(limitMap), nil
+}
+
 func getCloudDomain(cloud, region string) string {
 	// first, use the specified value
 	if cloud != "" {
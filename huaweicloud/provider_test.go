@@ -3,11 +3,17 @@ package huaweicloud
 import (
 	"context"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/pathorcontents"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
 )
@@ -120,6 +126,258 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func testDefaultTagsDiff(t *testing.T, forceNew bool, defaultTags map[string]interface{},
+	stateTags, configTags map[string]string) *terraform.InstanceDiff {
+	t.Helper()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": common.TagsSchema(),
+		},
+	}
+	if forceNew {
+		r.Schema["tags"] = common.TagsForceNewSchema()
+	}
+	wrapResourceWithDefaultTags(r)
+
+	state := &terraform.InstanceState{
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"tags": cty.NullVal(cty.Map(cty.String)),
+		}),
+	}
+	if stateTags != nil {
+		state.ID = "test"
+		state.Attributes = map[string]string{"id": "test", "tags.%": strconv.Itoa(len(stateTags))}
+		for k, v := range stateTags {
+			state.Attributes["tags."+k] = v
+		}
+	}
+	raw := make(map[string]interface{})
+	if len(configTags) > 0 {
+		rawTags := make(map[string]cty.Value)
+		tags := make(map[string]interface{})
+		for k, v := range configTags {
+			rawTags[k] = cty.StringVal(v)
+			tags[k] = v
+		}
+		state.RawConfig = cty.ObjectVal(map[string]cty.Value{"tags": cty.MapVal(rawTags)})
+		raw["tags"] = tags
+	}
+
+	conf := &config.Config{DefaultTags: defaultTags}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), conf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return diff
+}
+
+// getTagsDiff returns the planned tags which are changed, an empty value means the tag is removed.
+func getTagsDiff(diff *terraform.InstanceDiff) (map[string]string, bool) {
+	result := make(map[string]string)
+	var requiresNew bool
+	if diff == nil {
+		return result, requiresNew
+	}
+	for k, v := range diff.Attributes {
+		if !strings.HasPrefix(k, "tags.") || k == "tags.%" {
+			continue
+		}
+		result[strings.TrimPrefix(k, "tags.")] = v.New
+		requiresNew = requiresNew || v.RequiresNew
+	}
+	return result, requiresNew
+}
+
+func TestCustomizeDiffWithDefaultTags(t *testing.T) {
+	defaultTags := map[string]interface{}{"owner": "team"}
+
+	testCases := []struct {
+		name        string
+		forceNew    bool
+		stateTags   map[string]string
+		configTags  map[string]string
+		expected    map[string]string
+		requiresNew bool
+	}{
+		{
+			name:       "add",
+			configTags: map[string]string{"env": "test"},
+			expected:   map[string]string{"owner": "team", "env": "test"},
+		},
+		{
+			name:      "only defaults",
+			stateTags: map[string]string{"owner": "team"},
+			expected:  map[string]string{},
+		},
+		{
+			name:      "remove",
+			stateTags: map[string]string{"owner": "team", "env": "test"},
+			expected:  map[string]string{"env": ""},
+		},
+		{
+			name:       "override",
+			stateTags:  map[string]string{"owner": "team"},
+			configTags: map[string]string{"owner": "me"},
+			expected:   map[string]string{"owner": "me"},
+		},
+		{
+			// the changed default tags are not applied to the resources whose tags can not be updated
+			name:       "force new with changed defaults",
+			forceNew:   true,
+			stateTags:  map[string]string{"owner": "old-team", "env": "test"},
+			configTags: map[string]string{"env": "test"},
+			expected:   map[string]string{},
+		},
+		{
+			// all tags are planned for the new resource
+			name:        "force new with changed tags",
+			forceNew:    true,
+			stateTags:   map[string]string{"owner": "old-team", "env": "test"},
+			configTags:  map[string]string{"env": "prod"},
+			expected:    map[string]string{"owner": "team", "env": "prod"},
+			requiresNew: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		diff := testDefaultTagsDiff(t, tc.forceNew, defaultTags, tc.stateTags, tc.configTags)
+		tags, requiresNew := getTagsDiff(diff)
+		if !reflect.DeepEqual(tags, tc.expected) {
+			t.Fatalf("%s: expected the changed tags %v, but got %v", tc.name, tc.expected, tags)
+		}
+		if requiresNew != tc.requiresNew {
+			t.Fatalf("%s: expected requires new %v, but got %v", tc.name, tc.requiresNew, requiresNew)
+		}
+	}
+}
+
+func TestCheckDefaultTagsNotIgnored(t *testing.T) {
+	conf := &config.Config{
+		DefaultTags:          map[string]interface{}{"owner": "team", "auto:env": "test"},
+		IgnoreTagKeyPrefixes: []string{"auto:"},
+	}
+	err := checkDefaultTagsNotIgnored(conf)
+	if err == nil || !strings.Contains(err.Error(), "auto:env") {
+		t.Fatalf("expected the ignored default tag is rejected, but got %v", err)
+	}
+
+	conf.IgnoreTagKeyPrefixes = []string{"_sys_"}
+	if err = checkDefaultTagsNotIgnored(conf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestFilterIgnoredTags(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpcs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+	conf := &config.Config{
+		IgnoreTagKeys:        []string{"owner"},
+		IgnoreTagKeyPrefixes: []string{"_sys_"},
+	}
+	remoteTags := map[string]interface{}{"owner": "a", "_sys_scan": "b", "env": "test"}
+
+	testCases := []struct {
+		name      string
+		rawConfig cty.Value
+		expected  map[string]interface{}
+	}{
+		{
+			// the configuration is null when the resource is refreshed
+			name:      "refresh",
+			rawConfig: cty.NullVal(cty.DynamicPseudoType),
+			expected:  map[string]interface{}{"env": "test"},
+		},
+		{
+			// the tags filter of the data source is kept
+			name: "configured",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.MapVal(map[string]cty.Value{"owner": cty.StringVal("a")}),
+			}),
+			expected: map[string]interface{}{"owner": "a", "env": "test"},
+		},
+	}
+
+	for _, tc := range testCases {
+		d := r.Data(&terraform.InstanceState{RawConfig: tc.rawConfig})
+		d.SetId("test")
+		if err := d.Set("tags", remoteTags); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		vpcs := []map[string]interface{}{{"name": "vpc", "tags": remoteTags}}
+		if err := d.Set("vpcs", vpcs); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		filterIgnoredTags(d, conf, r.Schema)
+		if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, tc.expected) {
+			t.Fatalf("%s: expected the tags %v, but got %v", tc.name, tc.expected, tags)
+		}
+		if tags := d.Get("vpcs.0.tags").(map[string]interface{}); !reflect.DeepEqual(tags,
+			map[string]interface{}{"env": "test"}) {
+			t.Fatalf("%s: expected the ignored tags of the VPCs are removed, but got %v", tc.name, tags)
+		}
+		if name := d.Get("vpcs.0.name").(string); name != "vpc" {
+			t.Fatalf("%s: expected the other fields of the VPCs are kept, but got %s", tc.name, name)
+		}
+	}
+}
+
+func TestWrapResourceWithIgnoreTags_rejectConfigured(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	wrapResourceWithIgnoreTags(r)
+	conf := &config.Config{IgnoreTagKeyPrefixes: []string{"auto:"}}
+
+	raw := map[string]interface{}{"tags": map[string]interface{}{"auto:owner": "a"}}
+	state := &terraform.InstanceState{
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"tags": cty.MapVal(map[string]cty.Value{"auto:owner": cty.StringVal("a")}),
+		}),
+	}
+	_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), conf)
+	if err == nil || !strings.Contains(err.Error(), "auto:owner") {
+		t.Fatalf("expected the ignored tag key is rejected, but got %v", err)
+	}
+
+	conf.IgnoreTagKeyPrefixes = []string{"_sys_"}
+	if _, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), conf); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 // Steps for configuring HuaweiCloud with SSL validation are here:
 // https://github.com/hashicorp/terraform/pull/6279#issuecomment-219020144
 func TestAccProvider_caCertFile(t *testing.T) {
@@ -2,6 +2,7 @@ package huaweicloud
 
 import (
 	"context"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/chnsz/golangsdk"
 	v1rules "github.com/chnsz/golangsdk/openstack/networking/v1/security/rules"
@@ -20,6 +22,7 @@ import (
 	v3rules "github.com/chnsz/golangsdk/openstack/networking/v3/security/rules"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/logp"
 )
@@ -87,12 +90,112 @@ var securityGroupRuleSchema = &schema.Schema{
 	},
 }
 
+// securityGroupExclusiveRuleResource is the schema of the ingress and egress blocks, which are only managed in the
+// exclusive rules mode. The rule ID is not part of the schema, the rules are matched by the hash of all the fields.
+// The protocol and ports are compared regardless of the case and spaces.
+var securityGroupExclusiveRuleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"ethertype": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "IPv4",
+			ValidateFunc: validation.StringInSlice([]string{
+				"IPv4", "IPv6",
+			}, false),
+		},
+		"protocol": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: utils.SuppressCaseDiffs,
+			ValidateFunc: validation.Any(
+				validation.StringInSlice([]string{"tcp", "udp", "icmp", "icmpv6"}, true),
+				validation.StringMatch(regexp.MustCompile("^([0-1]?[0-9]?[0-9]|2[0-4][0-9]|25[0-5])$"),
+					"The valid protocol is range from 0 to 255.",
+				),
+			),
+		},
+		"ports": {
+			Type:     schema.TypeString,
+			Optional: true,
+			DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+				return normalizeSecurityGroupRulePorts(old) == normalizeSecurityGroupRulePorts(new)
+			},
+		},
+		"remote_ip_prefix": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.ValidateCIDR,
+		},
+		"remote_group_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"remote_address_group_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"action": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "allow",
+			ValidateFunc: validation.StringInSlice([]string{
+				"allow", "deny",
+			}, false),
+		},
+		"priority": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
+var hashSecurityGroupExclusiveRuleFields = schema.HashResource(securityGroupExclusiveRuleResource)
+
+// hashSecurityGroupExclusiveRule hashes the normalized rule, so the rule returned by the API and the declared rule have
+// the same hash even though they are written in different formats.
+func hashSecurityGroupExclusiveRule(v interface{}) int {
+	return hashSecurityGroupExclusiveRuleFields(normalizeSecurityGroupExclusiveRule(v.(map[string]interface{})))
+}
+
+// normalizeSecurityGroupExclusiveRule returns a copy of the rule in which the protocol is in lower case, the spaces
+// of the ports are removed and the remote CIDR is in the canonical format, e.g. 0:0:0:0:0:0:0:0/0 is ::/0.
+func normalizeSecurityGroupExclusiveRule(rule map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(rule))
+	for k, v := range rule {
+		result[k] = v
+	}
+
+	if protocol, ok := rule["protocol"].(string); ok {
+		result["protocol"] = strings.ToLower(protocol)
+	}
+	if ports, ok := rule["ports"].(string); ok {
+		result["ports"] = normalizeSecurityGroupRulePorts(ports)
+	}
+	if cidr, ok := rule["remote_ip_prefix"].(string); ok && cidr != "" {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			result["remote_ip_prefix"] = ipNet.String()
+		}
+	}
+	return result
+}
+
+func normalizeSecurityGroupRulePorts(ports string) string {
+	return strings.Join(strings.Fields(ports), "")
+}
+
 func ResourceNetworkingSecGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNetworkingSecGroupCreate,
 		ReadContext:   resourceNetworkingSecGroupRead,
 		UpdateContext: resourceNetworkingSecGroupUpdate,
 		DeleteContext: resourceNetworkingSecGroupDelete,
+		CustomizeDiff: resourceNetworkingSecGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -127,6 +230,22 @@ func ResourceNetworkingSecGroup() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"exclusive_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ingress": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     securityGroupExclusiveRuleResource,
+				Set:      hashSecurityGroupExclusiveRule,
+			},
+			"egress": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     securityGroupExclusiveRuleResource,
+				Set:      hashSecurityGroupExclusiveRule,
+			},
 			"rules": securityGroupRuleSchema,
 			"created_at": {
 				Type:     schema.TypeString,
@@ -186,6 +305,13 @@ func resourceNetworkingSecGroupCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	// In the exclusive rules mode, the default rules which are not declared will be deleted.
+	if d.Get("exclusive_rules").(bool) {
+		if err := reconcileSecurityGroupRules(v3Client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetworkingSecGroupRead(ctx, d, meta)
 }
 
@@ -198,6 +324,11 @@ func resourceNetworkingSecGroupCreateV1(ctx context.Context, d *schema.ResourceD
 		return fmtp.DiagErrorf("Error creating HuaweiCloud networking v1 client: %s", err)
 	}
 
+	if d.Get("exclusive_rules").(bool) {
+		return fmtp.DiagErrorf("The exclusive rules mode is not supported in region (%s), because the networking "+
+			"v3 API is not available", region)
+	}
+
 	// Only name and enterprise project ID are supported.
 	createOpts := v1groups.CreateOpts{
 		Name:                d.Get("name").(string),
@@ -270,6 +401,21 @@ func resourceNetworkingSecGroupRead(_ context.Context, d *schema.ResourceData, m
 			d.Set("created_at", v3Resp.CreatedAt),
 			d.Set("updated_at", v3Resp.UpdatedAt),
 		)
+
+		// In the exclusive rules mode, all the actual rules are set to the ingress and egress blocks, so the rules
+		// which are added or changed outside of Terraform are shown in the plan.
+		if d.Get("exclusive_rules").(bool) {
+			mErr = multierror.Append(mErr,
+				d.Set("ingress", flattenSecurityGroupExclusiveRules(v3Resp.SecurityGroupRules, "ingress")),
+				d.Set("egress", flattenSecurityGroupExclusiveRules(v3Resp.SecurityGroupRules, "egress")),
+			)
+		}
+	}
+	if !d.Get("exclusive_rules").(bool) {
+		mErr = multierror.Append(mErr,
+			d.Set("ingress", nil),
+			d.Set("egress", nil),
+		)
 	}
 
 	// If the query process returns an error, either because the specified region does not exist or the v3 API is
@@ -338,6 +484,124 @@ func flattenSecurityGroupRulesV3(rules []v3rules.SecurityGroupRule) ([]map[strin
 	return sgRules, nil
 }
 
+func flattenSecurityGroupExclusiveRule(rule v3rules.SecurityGroupRule) map[string]interface{} {
+	return normalizeSecurityGroupExclusiveRule(map[string]interface{}{
+		"ethertype":               rule.Ethertype,
+		"protocol":                rule.Protocol,
+		"ports":                   rule.MultiPort,
+		"remote_ip_prefix":        rule.RemoteIpPrefix,
+		"remote_group_id":         rule.RemoteGroupId,
+		"remote_address_group_id": rule.RemoteAddressGroupId,
+		"action":                  rule.Action,
+		"priority":                rule.Priority,
+		"description":             rule.Description,
+	})
+}
+
+func flattenSecurityGroupExclusiveRules(rules []v3rules.SecurityGroupRule, direction string) *schema.Set {
+	result := schema.NewSet(hashSecurityGroupExclusiveRule, nil)
+	for _, rule := range rules {
+		if rule.Direction == direction {
+			result.Add(flattenSecurityGroupExclusiveRule(rule))
+		}
+	}
+	return result
+}
+
+// reconcileSecurityGroupRules makes the rules of the security group exactly match the ingress and egress blocks:
+// the declared rules which do not exist are created first, and then the rules which are not declared (including the
+// rules added outside of Terraform) are deleted, so the traffic allowed by both the old and new rules is not
+// interrupted during the update.
+func reconcileSecurityGroupRules(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	secGroup, err := v3groups.Get(client, d.Id())
+	if err != nil {
+		return fmtp.Errorf("Error retrieving security group (%s): %s", d.Id(), err)
+	}
+
+	var createOpts []v3rules.CreateOpts
+	var deleteRules []v3rules.SecurityGroupRule
+	for _, direction := range []string{"ingress", "egress"} {
+		expected := make(map[int]map[string]interface{})
+		for _, v := range d.Get(direction).(*schema.Set).List() {
+			rule := normalizeSecurityGroupExclusiveRule(v.(map[string]interface{}))
+			expected[hashSecurityGroupExclusiveRule(rule)] = rule
+		}
+
+		existing := make(map[int]bool)
+		for _, rule := range secGroup.SecurityGroupRules {
+			if rule.Direction != direction {
+				continue
+			}
+			hash := hashSecurityGroupExclusiveRule(flattenSecurityGroupExclusiveRule(rule))
+			// The duplicate rules are deleted as well.
+			if _, ok := expected[hash]; ok && !existing[hash] {
+				existing[hash] = true
+				continue
+			}
+			deleteRules = append(deleteRules, rule)
+		}
+
+		for hash, rule := range expected {
+			if existing[hash] {
+				continue
+			}
+			createOpts = append(createOpts, v3rules.CreateOpts{
+				SecurityGroupId:      d.Id(),
+				Direction:            direction,
+				Ethertype:            rule["ethertype"].(string),
+				Protocol:             rule["protocol"].(string),
+				MultiPort:            rule["ports"].(string),
+				RemoteIpPrefix:       rule["remote_ip_prefix"].(string),
+				RemoteGroupId:        rule["remote_group_id"].(string),
+				RemoteAddressGroupId: rule["remote_address_group_id"].(string),
+				Action:               rule["action"].(string),
+				Priority:             rule["priority"].(int),
+				Description:          rule["description"].(string),
+			})
+		}
+	}
+
+	for _, opts := range createOpts {
+		logp.Printf("[DEBUG] Creating the %s rule of security group (%s): %#v", opts.Direction, d.Id(), opts)
+		_, err = v3rules.Create(client, opts)
+		if err != nil {
+			return fmtp.Errorf("Error creating %s rule of security group (%s): %s", opts.Direction, d.Id(), err)
+		}
+	}
+
+	for _, rule := range deleteRules {
+		logp.Printf("[DEBUG] Deleting the undeclared %s rule (%s) of security group (%s)", rule.Direction, rule.ID,
+			d.Id())
+		err = v3rules.Delete(client, rule.ID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmtp.Errorf("Error deleting security group rule (%s): %s", rule.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// resourceNetworkingSecGroupCustomizeDiff makes sure the ingress and egress blocks are only used in the exclusive
+// rules mode, and marks the rules list as unknown when the exclusive rules will be changed.
+func resourceNetworkingSecGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("exclusive_rules") {
+		return nil
+	}
+
+	if !d.Get("exclusive_rules").(bool) {
+		if d.Get("ingress").(*schema.Set).Len() > 0 || d.Get("egress").(*schema.Set).Len() > 0 {
+			return fmtp.Errorf("The ingress and egress blocks can only be specified when exclusive_rules is true")
+		}
+		return nil
+	}
+
+	if d.HasChanges("exclusive_rules", "ingress", "egress") {
+		return d.SetNewComputed("rules")
+	}
+	return nil
+}
+
 func resourceNetworkingSecGroupUpdate(ctx context.Context, d *schema.ResourceData,
 	meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
@@ -368,6 +632,12 @@ func resourceNetworkingSecGroupUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.Get("exclusive_rules").(bool) && d.HasChanges("exclusive_rules", "ingress", "egress") {
+		if err := reconcileSecurityGroupRules(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetworkingSecGroupRead(ctx, d, meta)
 }
 
@@ -9,10 +9,58 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/chnsz/golangsdk/openstack/networking/v1/security/securitygroups"
+	v3rules "github.com/chnsz/golangsdk/openstack/networking/v3/security/rules"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
 )
 
+func TestSecurityGroupExclusiveRuleHash(t *testing.T) {
+	declared := map[string]interface{}{
+		"ethertype":               "IPv6",
+		"protocol":                "TCP",
+		"ports":                   "22, 3389,80",
+		"remote_ip_prefix":        "::/0",
+		"remote_group_id":         "",
+		"remote_address_group_id": "",
+		"action":                  "allow",
+		"priority":                1,
+		"description":             "ssh and rdp",
+	}
+	actual := v3rules.SecurityGroupRule{
+		ID:             "rule-id",
+		Direction:      "ingress",
+		Ethertype:      "IPv6",
+		Protocol:       "tcp",
+		MultiPort:      "22,3389,80",
+		RemoteIpPrefix: "0:0:0:0:0:0:0:0/0",
+		Action:         "allow",
+		Priority:       1,
+		Description:    "ssh and rdp",
+	}
+
+	flattened := flattenSecurityGroupExclusiveRule(actual)
+	if hashSecurityGroupExclusiveRule(declared) != hashSecurityGroupExclusiveRule(flattened) {
+		t.Fatalf("the hash of the declared rule %v is different from the flattened rule %v", declared, flattened)
+	}
+	if flattened["protocol"] != "tcp" || flattened["ports"] != "22,3389,80" || flattened["remote_ip_prefix"] != "::/0" {
+		t.Fatalf("the flattened rule is not normalized: %v", flattened)
+	}
+
+	rules := flattenSecurityGroupExclusiveRules([]v3rules.SecurityGroupRule{actual}, "ingress")
+	if rules.Len() != 1 || !rules.Contains(declared) {
+		t.Fatalf("the flattened ingress rules %v do not contain the declared rule", rules.List())
+	}
+	if flattenSecurityGroupExclusiveRules([]v3rules.SecurityGroupRule{actual}, "egress").Len() != 0 {
+		t.Fatalf("the ingress rule should not be flattened into the egress rules")
+	}
+
+	actual.MultiPort = "22,3389"
+	flattened = flattenSecurityGroupExclusiveRule(actual)
+	if hashSecurityGroupExclusiveRule(declared) == hashSecurityGroupExclusiveRule(flattened) {
+		t.Fatalf("the rules with different ports should have different hashes")
+	}
+}
+
 func TestAccNetworkingV3SecGroup_basic(t *testing.T) {
 	var secGroup securitygroups.SecurityGroup
 	name := fmt.Sprintf("seg-acc-test-%s", acctest.RandString(5))
@@ -92,6 +140,70 @@ func TestAccNetworkingV3SecGroup_noDefaultRules(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV3SecGroup_exclusiveRules(t *testing.T) {
+	var secGroup securitygroups.SecurityGroup
+	name := fmt.Sprintf("seg-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_networking_secgroup.secgroup_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV3SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecGroup_exclusiveRules(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV3SecGroupExists(resourceName, &secGroup),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_rules", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "3"),
+				),
+			},
+			{
+				// Add a rule outside of Terraform, it should be deleted by the next apply.
+				PreConfig: func() {
+					testAccAddSecGroupRuleOutOfBand(t, &secGroup)
+				},
+				Config: testAccSecGroup_exclusiveRules(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "3"),
+				),
+			},
+			{
+				Config: testAccSecGroup_exclusiveRulesUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &secGroup.ID),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddSecGroupRuleOutOfBand(t *testing.T, secGroup *securitygroups.SecurityGroup) {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.NetworkingV3Client(HW_REGION_NAME)
+	if err != nil {
+		t.Fatalf("Error creating HuaweiCloud networking v3 client: %s", err)
+	}
+
+	opts := v3rules.CreateOpts{
+		SecurityGroupId: secGroup.ID,
+		Direction:       "ingress",
+		Ethertype:       "IPv4",
+		Protocol:        "tcp",
+		MultiPort:       "3389",
+		RemoteIpPrefix:  "0.0.0.0/0",
+	}
+	if _, err = v3rules.Create(client, opts); err != nil {
+		t.Fatalf("Error creating security group rule: %s", err)
+	}
+}
+
 func testAccCheckNetworkingV3SecGroupDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
 	networkingClient, err := config.NetworkingV1Client(HW_REGION_NAME)
@@ -182,3 +294,58 @@ resource "huaweicloud_networking_secgroup" "secgroup_1" {
 }
 `, name)
 }
+
+func testAccSecGroup_exclusiveRules(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc_address_group" "test" {
+  name      = "%[1]s"
+  addresses = ["192.168.10.10", "192.168.1.1-192.168.1.50"]
+}
+
+resource "huaweicloud_networking_secgroup" "secgroup_1" {
+  name            = "%[1]s"
+  description     = "security group acceptance test with exclusive rules"
+  exclusive_rules = true
+
+  ingress {
+    protocol         = "tcp"
+    ports            = "22"
+    remote_ip_prefix = "10.0.0.0/8"
+    description      = "allow SSH from the internal network"
+  }
+
+  ingress {
+    protocol                = "tcp"
+    ports                   = "443"
+    remote_address_group_id = huaweicloud_vpc_address_group.test.id
+  }
+
+  egress {
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`, name)
+}
+
+func testAccSecGroup_exclusiveRulesUpdate(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc_address_group" "test" {
+  name      = "%[1]s"
+  addresses = ["192.168.10.10", "192.168.1.1-192.168.1.50"]
+}
+
+resource "huaweicloud_networking_secgroup" "secgroup_1" {
+  name            = "%[1]s"
+  description     = "security group acceptance test with exclusive rules"
+  exclusive_rules = true
+
+  ingress {
+    protocol                = "tcp"
+    ports                   = "443"
+    action                  = "deny"
+    priority                = 10
+    remote_address_group_id = huaweicloud_vpc_address_group.test.id
+  }
+}
+`, name)
+}
@@ -3,13 +3,19 @@ package acceptance
 
 import (
 	"fmt"
+	"hash/fnv"
+	"math/rand"
 	"os"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 )
 
 var (
@@ -114,8 +120,26 @@ var (
 	// The OBS address where the variable archive corresponding to the HCL/JSON template is located.
 	HW_RF_VARIABLES_ARCHIVE_URI = os.Getenv("HW_RF_VARIABLES_ARCHIVE_URI")
 
-	// The direct connection ID (provider does not support direct connection resource).
+	// The ID of the existing direct connection (the physical line must be installed before the connection is used).
 	HW_DC_DIRECT_CONNECT_ID = os.Getenv("HW_DC_DIRECT_CONNECT_ID")
+	// The ID of the operations connection on which the hosted connection is created.
+	HW_DC_HOSTING_ID = os.Getenv("HW_DC_HOSTING_ID")
+	// The project ID of the tenant to which the hosted connection is allocated.
+	HW_DC_RESOURCE_TENANT_ID = os.Getenv("HW_DC_RESOURCE_TENANT_ID")
+
+	// The account ID and the cloud connection ID of the peer account, which are used for the cross-account
+	// authorization of the network instance.
+	HW_CC_PEER_DOMAIN_ID     = os.Getenv("HW_CC_PEER_DOMAIN_ID")
+	HW_CC_PEER_CONNECTION_ID = os.Getenv("HW_CC_PEER_CONNECTION_ID")
+
+	// The ID of the VPN gateway which supports the certificate authentication, and the paths of the signing
+	// certificate, the encryption certificate, their private keys and the CA certificate chain.
+	HW_VPN_GATEWAY_ID               = os.Getenv("HW_VPN_GATEWAY_ID")
+	HW_VPN_CERTIFICATE_PATH         = os.Getenv("HW_VPN_CERTIFICATE_PATH")
+	HW_VPN_CERTIFICATE_KEY_PATH     = os.Getenv("HW_VPN_CERTIFICATE_KEY_PATH")
+	HW_VPN_CERTIFICATE_CHAIN_PATH   = os.Getenv("HW_VPN_CERTIFICATE_CHAIN_PATH")
+	HW_VPN_ENC_CERTIFICATE_PATH     = os.Getenv("HW_VPN_ENC_CERTIFICATE_PATH")
+	HW_VPN_ENC_CERTIFICATE_KEY_PATH = os.Getenv("HW_VPN_ENC_CERTIFICATE_KEY_PATH")
 
 	// The CFW instance ID
 	HW_CFW_INSTANCE_ID = os.Getenv("HW_CFW_INSTANCE_ID")
@@ -164,7 +188,31 @@ var TestAccProviderFactories map[string]func() (*schema.Provider, error)
 // TestAccProvider is the "main" provider instance
 var TestAccProvider *schema.Provider
 
+// vcrRandomSeed is used to generate the same random resource names in the record and replay modes,
+// so that the requests can match the recorded interactions.
+const vcrRandomSeed int64 = 20230601
+
+var (
+	// vcrRandomSources stores the random sources of the running tests in the cassette mode, the key is the test name.
+	// Each test has its own source, so the names are the same in the record and replay modes even though the
+	// parallel tests are interleaved differently.
+	vcrRandomSources = make(map[string]*rand.Rand)
+	vcrRandomLock    sync.Mutex
+)
+
 func init() {
+	if vcrMode := config.GetVcrMode(); vcrMode != "" {
+		//nolint:staticcheck
+		rand.Seed(vcrRandomSeed)
+
+		// the credentials are not required when replaying the recorded interactions
+		if vcrMode == config.VcrModeReplay && HW_ACCESS_KEY == "" && HW_SECRET_KEY == "" {
+			HW_ACCESS_KEY, HW_SECRET_KEY = "replay-access-key", "replay-secret-key"
+			os.Setenv("HW_ACCESS_KEY", HW_ACCESS_KEY)
+			os.Setenv("HW_SECRET_KEY", HW_SECRET_KEY)
+		}
+	}
+
 	TestAccProvider = huaweicloud.Provider()
 
 	TestAccProviders = map[string]*schema.Provider{
@@ -278,25 +326,93 @@ func TestAccPreCheckMaas(t *testing.T) {
 }
 
 func RandomAccResourceName() string {
-	return fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+	return fmt.Sprintf("tf_test_%s", randStringFromCharSet(5, acctest.CharSetAlpha))
 }
 
 func RandomAccResourceNameWithDash() string {
-	return fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	return fmt.Sprintf("tf-test-%s", randStringFromCharSet(5, acctest.CharSetAlpha))
 }
 
 func RandomCidr() string {
-	return fmt.Sprintf("172.16.%d.0/24", acctest.RandIntRange(0, 255))
+	return fmt.Sprintf("172.16.%d.0/24", randIntRange(0, 255))
 }
 
 func RandomCidrAndGatewayIp() (string, string) {
-	seed := acctest.RandIntRange(0, 255)
+	seed := randIntRange(0, 255)
 	return fmt.Sprintf("172.16.%d.0/24", seed), fmt.Sprintf("172.16.%d.1", seed)
 }
 
 func RandomPassword() string {
-	return fmt.Sprintf("%s%s%s%d", acctest.RandStringFromCharSet(2, "ABCDEFGHIJKLMNOPQRSTUVWXZY"),
-		acctest.RandString(3), acctest.RandStringFromCharSet(2, "~!@#%^*-_=+?"), acctest.RandIntRange(1000, 9999))
+	return fmt.Sprintf("%s%s%s%d", randStringFromCharSet(2, "ABCDEFGHIJKLMNOPQRSTUVWXZY"),
+		randStringFromCharSet(3, acctest.CharSetAlpha), randStringFromCharSet(2, "~!@#%^*-_=+?"),
+		randIntRange(1000, 9999))
+}
+
+// getVcrRandomSource returns the random source of the running test in the cassette mode, or nil if the cassette
+// mode is not enabled or the caller is not a test function.
+func getVcrRandomSource() *rand.Rand {
+	if config.GetVcrMode() == "" {
+		return nil
+	}
+
+	testName := getCallerTestName()
+	if testName == "" {
+		return nil
+	}
+
+	vcrRandomLock.Lock()
+	defer vcrRandomLock.Unlock()
+
+	source, ok := vcrRandomSources[testName]
+	if !ok {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(testName))
+		// #nosec G404
+		source = rand.New(rand.NewSource(vcrRandomSeed ^ int64(h.Sum64())))
+		vcrRandomSources[testName] = source
+	}
+	return source
+}
+
+// getCallerTestName returns the name of the test function in the call stack, e.g. TestAccVpc_basic.
+func getCallerTestName() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		// the name format is package.TestXxx or package.TestXxx.func1 for the closures
+		if parts := strings.Split(name, "."); len(parts) > 1 && strings.HasPrefix(parts[1], "Test") {
+			return parts[1]
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func randIntRange(min, max int) int {
+	if source := getVcrRandomSource(); source != nil {
+		vcrRandomLock.Lock()
+		defer vcrRandomLock.Unlock()
+		return source.Intn(max-min) + min
+	}
+	return acctest.RandIntRange(min, max)
+}
+
+func randStringFromCharSet(strlen int, charSet string) string {
+	source := getVcrRandomSource()
+	if source == nil {
+		return acctest.RandStringFromCharSet(strlen, charSet)
+	}
+
+	vcrRandomLock.Lock()
+	defer vcrRandomLock.Unlock()
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[source.Intn(len(charSet))]
+	}
+	return string(result)
 }
 
 // lintignore:AT003
@@ -575,6 +691,31 @@ func TestAccPreCheckDcDirectConnection(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckDcHostedConnection(t *testing.T) {
+	if HW_DC_HOSTING_ID == "" || HW_DC_RESOURCE_TENANT_ID == "" {
+		t.Skip("HW_DC_HOSTING_ID and HW_DC_RESOURCE_TENANT_ID must be set for the hosted connection acceptance test")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckCCAuthorization(t *testing.T) {
+	if HW_CC_PEER_DOMAIN_ID == "" || HW_CC_PEER_CONNECTION_ID == "" {
+		t.Skip("HW_CC_PEER_DOMAIN_ID and HW_CC_PEER_CONNECTION_ID must be set for the CC authorization acceptance test")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckVpnGatewayCertificate(t *testing.T) {
+	if HW_VPN_GATEWAY_ID == "" || HW_VPN_CERTIFICATE_PATH == "" || HW_VPN_CERTIFICATE_KEY_PATH == "" ||
+		HW_VPN_CERTIFICATE_CHAIN_PATH == "" || HW_VPN_ENC_CERTIFICATE_PATH == "" ||
+		HW_VPN_ENC_CERTIFICATE_KEY_PATH == "" {
+		t.Skip("HW_VPN_GATEWAY_ID, HW_VPN_CERTIFICATE_PATH, HW_VPN_CERTIFICATE_KEY_PATH, " +
+			"HW_VPN_CERTIFICATE_CHAIN_PATH, HW_VPN_ENC_CERTIFICATE_PATH and HW_VPN_ENC_CERTIFICATE_KEY_PATH must be " +
+			"set for the VPN gateway certificate acceptance test")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckCfw(t *testing.T) {
 	if HW_CFW_INSTANCE_ID == "" {
@@ -0,0 +1,40 @@
+package acceptance
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestRandomAccResourceName_vcrMode(t *testing.T) {
+	t.Setenv("HW_VCR_MODE", "replay")
+
+	generate := func() []string {
+		vcrRandomLock.Lock()
+		delete(vcrRandomSources, t.Name())
+		vcrRandomLock.Unlock()
+		return []string{RandomAccResourceName(), RandomCidr(), RandomPassword()}
+	}
+
+	recorded := generate()
+	// the global random source is consumed by other tests which run in parallel
+	// #nosec G404
+	rand.Int()
+	replayed := generate()
+	for i := range recorded {
+		AssertEquals(t, replayed[i], recorded[i])
+	}
+	AssertEquals(t, strings.HasPrefix(recorded[0], "tf_test_"), true)
+	AssertEquals(t, len(recorded[0]), len("tf_test_")+5)
+
+	var otherName string
+	func() {
+		// the closures are still the part of the test
+		otherName = RandomAccResourceName()
+	}()
+	AssertEquals(t, otherName != recorded[0], true)
+}
+
+func TestGetCallerTestName(t *testing.T) {
+	AssertEquals(t, func() string { return getCallerTestName() }(), "TestGetCallerTestName")
+}
@@ -0,0 +1,150 @@
+package cc
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getBandwidthPackageResourceFunc(config *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getBandwidthPackage: Query the bandwidth package
+	var (
+		getBandwidthPackageHttpUrl = "v3/{domain_id}/ccaas/bandwidth-packages/{id}"
+		getBandwidthPackageProduct = "cc"
+	)
+	getBandwidthPackageClient, err := config.NewServiceClient(getBandwidthPackageProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BandwidthPackage Client: %s", err)
+	}
+
+	getBandwidthPackagePath := getBandwidthPackageClient.Endpoint + getBandwidthPackageHttpUrl
+	getBandwidthPackagePath = strings.ReplaceAll(getBandwidthPackagePath, "{domain_id}", config.DomainID)
+	getBandwidthPackagePath = strings.ReplaceAll(getBandwidthPackagePath, "{id}", state.Primary.ID)
+
+	getBandwidthPackageOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getBandwidthPackageResp, err := getBandwidthPackageClient.Request("GET", getBandwidthPackagePath,
+		&getBandwidthPackageOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving BandwidthPackage: %s", err)
+	}
+	return utils.FlattenResponse(getBandwidthPackageResp)
+}
+
+func TestAccBandwidthPackage_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_cc_bandwidth_package.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getBandwidthPackageResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testBandwidthPackage_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "local_area_id", "Chinese-Mainland"),
+					resource.TestCheckResourceAttr(rName, "remote_area_id", "Chinese-Mainland"),
+					resource.TestCheckResourceAttr(rName, "charge_mode", "bandwidth"),
+					resource.TestCheckResourceAttr(rName, "billing_mode", "3"),
+					resource.TestCheckResourceAttr(rName, "bandwidth", "5"),
+					resource.TestCheckResourceAttr(rName, "description", "demo_description"),
+					resource.TestCheckResourceAttr(rName, "resource_id", ""),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testBandwidthPackage_basic_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"_update"),
+					resource.TestCheckResourceAttr(rName, "bandwidth", "10"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttrPair(rName, "resource_id", "huaweicloud_cc_connection.test", "id"),
+					resource.TestCheckResourceAttr(rName, "resource_type", "cloud_connection"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testBandwidthPackage_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "resource_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testBandwidthPackage_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_cc_connection" "test" {
+  name                  = "%s"
+  enterprise_project_id = "0"
+}
+`, name)
+}
+
+func testBandwidthPackage_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cc_bandwidth_package" "test" {
+  name                  = "%[2]s"
+  local_area_id         = "Chinese-Mainland"
+  remote_area_id        = "Chinese-Mainland"
+  charge_mode           = "bandwidth"
+  billing_mode          = "3"
+  bandwidth             = 5
+  project_id            = "%[3]s"
+  description           = "demo_description"
+  enterprise_project_id = "0"
+}
+`, testBandwidthPackage_base(name), name, acceptance.HW_PROJECT_ID)
+}
+
+func testBandwidthPackage_basic_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cc_bandwidth_package" "test" {
+  name                  = "%[2]s_update"
+  local_area_id         = "Chinese-Mainland"
+  remote_area_id        = "Chinese-Mainland"
+  charge_mode           = "bandwidth"
+  billing_mode          = "3"
+  bandwidth             = 10
+  project_id            = "%[3]s"
+  enterprise_project_id = "0"
+  resource_id           = huaweicloud_cc_connection.test.id
+  resource_type         = "cloud_connection"
+}
+`, testBandwidthPackage_base(name), name, acceptance.HW_PROJECT_ID)
+}
@@ -0,0 +1,177 @@
+package cc
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getInterRegionBandwidthResourceFunc(config *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getInterRegionBandwidth: Query the inter-region bandwidth
+	var (
+		getInterRegionBandwidthHttpUrl = "v3/{domain_id}/ccaas/inter-region-bandwidths/{id}"
+		getInterRegionBandwidthProduct = "cc"
+	)
+	getInterRegionBandwidthClient, err := config.NewServiceClient(getInterRegionBandwidthProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating InterRegionBandwidth Client: %s", err)
+	}
+
+	getInterRegionBandwidthPath := getInterRegionBandwidthClient.Endpoint + getInterRegionBandwidthHttpUrl
+	getInterRegionBandwidthPath = strings.ReplaceAll(getInterRegionBandwidthPath, "{domain_id}", config.DomainID)
+	getInterRegionBandwidthPath = strings.ReplaceAll(getInterRegionBandwidthPath, "{id}", state.Primary.ID)
+
+	getInterRegionBandwidthOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getInterRegionBandwidthResp, err := getInterRegionBandwidthClient.Request("GET", getInterRegionBandwidthPath,
+		&getInterRegionBandwidthOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving InterRegionBandwidth: %s", err)
+	}
+	return utils.FlattenResponse(getInterRegionBandwidthResp)
+}
+
+func TestAccInterRegionBandwidth_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_cc_inter_region_bandwidth.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getInterRegionBandwidthResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testInterRegionBandwidth_basic(name, 5),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "cloud_connection_id",
+						"huaweicloud_cc_connection.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "bandwidth_package_id",
+						"huaweicloud_cc_bandwidth_package.test", "id"),
+					resource.TestCheckResourceAttr(rName, "bandwidth", "5"),
+					resource.TestCheckResourceAttr(rName, "inter_region_ids.0", acceptance.HW_REGION_NAME),
+					resource.TestCheckResourceAttr(rName, "inter_region_ids.1", acceptance.HW_DEST_REGION),
+					resource.TestCheckResourceAttr(rName, "inter_regions.#", "2"),
+				),
+			},
+			{
+				Config: testInterRegionBandwidth_basic(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bandwidth", "10"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"inter_region_ids",
+				},
+			},
+		},
+	})
+}
+
+func testInterRegionBandwidth_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc" "local" {
+  name = "%[1]s_local"
+  cidr = "192.168.0.0/24"
+}
+
+resource "huaweicloud_vpc" "remote" {
+  region = "%[2]s"
+  name   = "%[1]s_remote"
+  cidr   = "192.168.1.0/24"
+}
+
+resource "huaweicloud_cc_connection" "test" {
+  name                  = "%[1]s"
+  enterprise_project_id = "0"
+}
+
+resource "huaweicloud_cc_network_instance" "local" {
+  type                = "vpc"
+  cloud_connection_id = huaweicloud_cc_connection.test.id
+  instance_id         = huaweicloud_vpc.local.id
+  project_id          = "%[3]s"
+  region_id           = huaweicloud_vpc.local.region
+
+  cidrs = [
+    huaweicloud_vpc.local.cidr,
+  ]
+}
+
+resource "huaweicloud_cc_network_instance" "remote" {
+  type                = "vpc"
+  cloud_connection_id = huaweicloud_cc_connection.test.id
+  instance_id         = huaweicloud_vpc.remote.id
+  project_id          = "%[4]s"
+  region_id           = huaweicloud_vpc.remote.region
+
+  cidrs = [
+    huaweicloud_vpc.remote.cidr,
+  ]
+}
+
+resource "huaweicloud_cc_bandwidth_package" "test" {
+  name                  = "%[1]s"
+  local_area_id         = "Chinese-Mainland"
+  remote_area_id        = "Chinese-Mainland"
+  charge_mode           = "bandwidth"
+  billing_mode          = "3"
+  bandwidth             = 20
+  project_id            = "%[3]s"
+  enterprise_project_id = "0"
+  resource_id           = huaweicloud_cc_connection.test.id
+  resource_type         = "cloud_connection"
+}
+`, name, acceptance.HW_DEST_REGION, acceptance.HW_PROJECT_ID, acceptance.HW_DEST_PROJECT_ID)
+}
+
+func testInterRegionBandwidth_basic(name string, bandwidth int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cc_inter_region_bandwidth" "test" {
+  cloud_connection_id  = huaweicloud_cc_connection.test.id
+  bandwidth_package_id = huaweicloud_cc_bandwidth_package.test.id
+  bandwidth            = %[2]d
+
+  inter_region_ids = [
+    "%[3]s",
+    "%[4]s",
+  ]
+
+  depends_on = [
+    huaweicloud_cc_network_instance.local,
+    huaweicloud_cc_network_instance.remote,
+  ]
+}
+`, testInterRegionBandwidth_base(name), bandwidth, acceptance.HW_REGION_NAME, acceptance.HW_DEST_REGION)
+}
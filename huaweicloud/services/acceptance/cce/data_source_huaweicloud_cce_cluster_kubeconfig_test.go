@@ -0,0 +1,49 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccClusterKubeConfigDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	dataSourceName := "data.huaweicloud_cce_cluster_kubeconfig.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterKubeConfigDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_id", "huaweicloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "current_context", "internal"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "host"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "client_certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "client_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "kube_config_raw"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "contexts.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterKubeConfigDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_cce_cluster_kubeconfig" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+  duration   = 1
+}
+`, testAccCluster_basic(rName))
+}
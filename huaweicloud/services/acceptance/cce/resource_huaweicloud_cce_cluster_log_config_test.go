@@ -0,0 +1,130 @@
+package cce
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getClusterLogConfigResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.CceV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	requestPath := client.Endpoint + "api/v3/projects/{project_id}/cluster/{cluster_id}/log-configs"
+	requestPath = strings.ReplaceAll(requestPath, "{project_id}", client.ProjectID)
+	requestPath = strings.ReplaceAll(requestPath, "{cluster_id}", state.Primary.ID)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", requestPath, &opt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// the log config always exists, so the resource is regarded as deleted when all the components are disabled
+	enabled := utils.PathSearch("log_configs[?enable]", respBody, make([]interface{}, 0)).([]interface{})
+	if len(enabled) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return respBody, nil
+}
+
+func TestAccClusterLogConfig_basic(t *testing.T) {
+	var logConfig interface{}
+	resourceName := "huaweicloud_cce_cluster_log_config.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&logConfig,
+		getClusterLogConfigResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterLogConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "huaweicloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ttl_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "log_configs.#", "2"),
+				),
+			},
+			{
+				Config: testAccClusterLogConfig_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "ttl_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "log_configs.#", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccClusterLogConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_cluster_log_config" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+
+  log_configs {
+    name = "kube-apiserver"
+  }
+  log_configs {
+    name = "audit"
+  }
+}
+`, testAccCluster_basic(rName))
+}
+
+func testAccClusterLogConfig_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_cluster_log_config" "test" {
+  cluster_id  = huaweicloud_cce_cluster.test.id
+  ttl_in_days = 30
+
+  log_configs {
+    name = "kube-apiserver"
+  }
+  log_configs {
+    name = "kube-controller-manager"
+  }
+  log_configs {
+    name = "kube-scheduler"
+  }
+  log_configs {
+    name = "audit"
+  }
+}
+`, testAccCluster_basic(rName))
+}
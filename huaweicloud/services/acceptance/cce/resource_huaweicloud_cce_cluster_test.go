@@ -2,6 +2,7 @@ package cce
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -213,6 +214,38 @@ func TestAccCluster_hibernate(t *testing.T) {
 	})
 }
 
+func TestAccCluster_upgrade(t *testing.T) {
+	var cluster clusters.Clusters
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCluster_upgrade(rName, "cce.s1.small", "v1.23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "cce.s1.small"),
+				),
+			},
+			{
+				Config: testAccCluster_upgrade(rName, "cce.s1.medium", "v1.25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterNotRecreated(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "status", "Available"),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "cce.s1.medium"),
+					resource.TestMatchResourceAttr(resourceName, "cluster_version", regexp.MustCompile(`^v1\.25`)),
+					resource.TestCheckResourceAttrSet(resourceName, "upgrade_task_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCluster_multiContainerNetworkCidrs(t *testing.T) {
 	var cluster clusters.Clusters
 
@@ -295,6 +328,21 @@ func testAccCheckClusterExists(n string, cluster *clusters.Clusters) resource.Te
 	}
 }
 
+func testAccCheckClusterNotRecreated(n string, cluster *clusters.Clusters) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != cluster.Metadata.Id {
+			return fmt.Errorf("the cluster is recreated, the ID is changed from %s to %s", cluster.Metadata.Id,
+				rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCluster_prePaid(rName string, isAutoRenew bool) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -503,6 +551,26 @@ resource "huaweicloud_cce_cluster" "test" {
 `, common.TestVpc(rName), rName)
 }
 
+func testAccCluster_upgrade(rName, flavor, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_cluster" "test" {
+  name                   = "%s"
+  flavor_id              = "%s"
+  cluster_version        = "%s"
+  vpc_id                 = huaweicloud_vpc.test.id
+  subnet_id              = huaweicloud_vpc_subnet.test.id
+  container_network_type = "overlay_l2"
+  service_network_cidr   = "10.248.0.0/16"
+
+  timeouts {
+    update = "2h"
+  }
+}
+`, common.TestVpc(rName), rName, flavor, version)
+}
+
 func testAccCluster_multiContainerNetworkCidrs(rName string) string {
 	return fmt.Sprintf(`
 %s
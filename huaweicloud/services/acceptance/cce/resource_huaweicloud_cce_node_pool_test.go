@@ -73,6 +73,39 @@ func TestAccCCENodePool_basic(t *testing.T) {
 	})
 }
 
+func TestAccCCENodePool_upgradeStrategy(t *testing.T) {
+	var nodePool nodepools.NodePool
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_cce_node_pool.test"
+	clusterName := "huaweicloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCENodePool_upgradeStrategy(rName, "s6.large.2", 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodePoolExists(resourceName, clusterName, &nodePool),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_strategy.0.max_surge", "1"),
+				),
+			},
+			{
+				Config: testAccCCENodePool_upgradeStrategy(rName, "s6.xlarge.2", 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &nodePool.Metadata.Id),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "s6.xlarge.2"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume.0.size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCCENodePool_tagsLabelsTaints(t *testing.T) {
 	var nodePool nodepools.NodePool
 
@@ -435,6 +468,37 @@ resource "huaweicloud_cce_node_pool" "test" {
 `, testAccCCENodePool_Base(rName), updateName)
 }
 
+func testAccCCENodePool_upgradeStrategy(rName, flavor string, rootSize int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_node_pool" "test" {
+  cluster_id         = huaweicloud_cce_cluster.test.id
+  name               = "%s"
+  os                 = "EulerOS 2.5"
+  flavor_id          = "%s"
+  initial_node_count = 2
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+  key_pair           = huaweicloud_compute_keypair.test.name
+
+  root_volume {
+    size       = %d
+    volumetype = "SSD"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SSD"
+  }
+
+  upgrade_strategy {
+    max_unavailable = 0
+    max_surge       = 1
+    drain_timeout   = 300
+  }
+}
+`, testAccCCENodePool_Base(rName), rName, flavor, rootSize)
+}
+
 func testAccCCENodePool_volume_extendParams(rName string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,46 @@
+package dc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceConnections_basic(t *testing.T) {
+	var (
+		dName = "data.huaweicloud_dc_connections.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDcDirectConnection(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnections_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "connections.#", "1"),
+					resource.TestCheckResourceAttr(dName, "connections.0.id", acceptance.HW_DC_DIRECT_CONNECT_ID),
+					resource.TestCheckResourceAttrSet(dName, "connections.0.name"),
+					resource.TestCheckResourceAttrSet(dName, "connections.0.bandwidth"),
+					resource.TestCheckResourceAttrSet(dName, "connections.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConnections_basic() string {
+	return fmt.Sprintf(`
+data "huaweicloud_dc_connections" "test" {
+  connection_id = "%s"
+}
+`, acceptance.HW_DC_DIRECT_CONNECT_ID)
+}
@@ -0,0 +1,54 @@
+package dc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceVirtualInterfaces_basic(t *testing.T) {
+	var (
+		dName = "data.huaweicloud_dc_virtual_interfaces.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+		name  = acceptance.RandomAccResourceName()
+		vlan  = acctest.RandIntRange(1, 3999)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDcDirectConnection(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVirtualInterfaces_basic(name, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "virtual_interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair(dName, "virtual_interfaces.0.id",
+						"huaweicloud_dc_virtual_interface.test", "id"),
+					resource.TestCheckResourceAttr(dName, "virtual_interfaces.0.name", name),
+					resource.TestCheckResourceAttr(dName, "virtual_interfaces.0.direct_connect_id",
+						acceptance.HW_DC_DIRECT_CONNECT_ID),
+					resource.TestCheckResourceAttr(dName, "virtual_interfaces.0.vlan", fmt.Sprintf("%v", vlan)),
+					resource.TestCheckResourceAttrSet(dName, "virtual_interfaces.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVirtualInterfaces_basic(name string, vlan int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "huaweicloud_dc_virtual_interfaces" "test" {
+  interface_id = huaweicloud_dc_virtual_interface.test.id
+}
+`, testAccVirtualInterface_basic(name, vlan))
+}
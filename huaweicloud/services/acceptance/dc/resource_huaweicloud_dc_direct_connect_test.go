@@ -0,0 +1,94 @@
+package dc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dc"
+)
+
+func getDirectConnectFunc(config *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := config.DcV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	return dc.GetDirectConnect(client, state.Primary.ID, state.Primary.Attributes["hosting_id"])
+}
+
+func TestAccDirectConnect_hosted(t *testing.T) {
+	var (
+		connection interface{}
+
+		rName      = "huaweicloud_dc_direct_connect.test"
+		name       = acceptance.RandomAccResourceName()
+		updateName = acceptance.RandomAccResourceName()
+		vlan       = acctest.RandIntRange(1, 3999)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&connection,
+		getDirectConnectFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDcHostedConnection(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectConnect_hosted(name, vlan, 10),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+					resource.TestCheckResourceAttr(rName, "bandwidth", "10"),
+					resource.TestCheckResourceAttr(rName, "hosting_id", acceptance.HW_DC_HOSTING_ID),
+					resource.TestCheckResourceAttr(rName, "vlan", fmt.Sprintf("%v", vlan)),
+					resource.TestCheckResourceAttr(rName, "type", "hosted"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccDirectConnect_hosted(updateName, vlan, 20),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "bandwidth", "20"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resource_tenant_id",
+				},
+			},
+		},
+	})
+}
+
+func testAccDirectConnect_hosted(name string, vlan, bandwidth int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dc_direct_connect" "test" {
+  name               = "%[1]s"
+  description        = "Created by acc test"
+  bandwidth          = %[2]d
+  hosting_id         = "%[3]s"
+  vlan               = %[4]d
+  resource_tenant_id = "%[5]s"
+}
+`, name, bandwidth, acceptance.HW_DC_HOSTING_ID, vlan, acceptance.HW_DC_RESOURCE_TENANT_ID)
+}
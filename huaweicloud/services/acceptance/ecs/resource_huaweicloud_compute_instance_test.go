@@ -284,6 +284,58 @@ func TestAccComputeInstance_withEPS(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_rebuild(t *testing.T) {
+	var instance cloudservers.CloudServer
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_compute_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_rebuild(rName, "data.huaweicloud_images_image.test.id", "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "data.huaweicloud_images_image.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rebuild_on_image_change", "true"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_rebuild(rName, "data.huaweicloud_images_image.rebuild.id", "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceNotRecreated(resourceName, &instance),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "data.huaweicloud_images_image.rebuild", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_name", "data.huaweicloud_images_image.rebuild", "name"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_rebuild(rName, "data.huaweicloud_images_image.rebuild.id", "echo world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceNotRecreated(resourceName, &instance),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "data.huaweicloud_images_image.rebuild", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeInstanceNotRecreated(n string, instance *cloudservers.CloudServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID != instance.ID {
+			return fmt.Errorf("the instance was recreated, the ID is changed from %s to %s", instance.ID, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckComputeInstanceDestroy(s *terraform.State) error {
 	cfg := acceptance.TestAccProvider.Meta().(*config.Config)
 	computeClient, err := cfg.ComputeV1Client(acceptance.HW_REGION_NAME)
@@ -568,6 +620,32 @@ resource "huaweicloud_compute_instance" "test" {
 `, testAccCompute_data, rName, rName)
 }
 
+func testAccComputeInstance_rebuild(rName, imageID, userData string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "huaweicloud_images_image" "rebuild" {
+  name        = "Ubuntu 20.04 server 64bit"
+  most_recent = true
+}
+
+resource "huaweicloud_compute_instance" "test" {
+  name                    = "%[2]s"
+  image_id                = %[3]s
+  flavor_id               = data.huaweicloud_compute_flavors.test.ids[0]
+  security_group_ids      = [data.huaweicloud_networking_secgroup.test.id]
+  availability_zone       = data.huaweicloud_availability_zones.test.names[0]
+  admin_pass              = "Test@123"
+  user_data               = "#!/bin/bash\n%[4]s"
+  rebuild_on_image_change = true
+
+  network {
+    uuid = data.huaweicloud_vpc_subnet.test.id
+  }
+}
+`, testAccCompute_data, rName, imageID, userData)
+}
+
 func testAccComputeInstance_withEPS(rName, epsID string) string {
 	return fmt.Sprintf(`
 %s
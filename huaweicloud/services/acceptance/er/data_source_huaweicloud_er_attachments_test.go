@@ -0,0 +1,72 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccAttachmentsDataSource_basic(t *testing.T) {
+	var (
+		dName    = "data.huaweicloud_er_attachments.test"
+		name     = acceptance.RandomAccResourceName()
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+
+		dc = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttachmentsDataSource_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dName, "attachments.0.id",
+						"huaweicloud_er_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttrPair(dName, "attachments.0.resource_id", "huaweicloud_vpc.test", "id"),
+					resource.TestCheckResourceAttr(dName, "attachments.0.type", "vpc"),
+					resource.TestCheckResourceAttr(dName, "attachments.0.name", name),
+					resource.TestCheckResourceAttr(dName, "attachments.0.tags.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAttachmentsDataSource_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_vpc_attachment" "test" {
+  instance_id = huaweicloud_er_instance.test.id
+  vpc_id      = huaweicloud_vpc.test.id
+  subnet_id   = huaweicloud_vpc_subnet.test.id
+  name        = "%[2]s"
+
+  tags = {
+    foo = "bar"
+  }
+}
+
+data "huaweicloud_er_attachments" "test" {
+  instance_id = huaweicloud_er_instance.test.id
+  type        = "vpc"
+
+  tags = {
+    foo = "bar"
+  }
+
+  depends_on = [huaweicloud_er_vpc_attachment.test]
+}
+`, testVpcAttachment_base(name, bgpAsNum), name)
+}
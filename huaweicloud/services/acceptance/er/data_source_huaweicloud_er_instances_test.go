@@ -0,0 +1,58 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccInstancesDataSource_basic(t *testing.T) {
+	var (
+		dName    = "data.huaweicloud_er_instances.test"
+		name     = acceptance.RandomAccResourceName()
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+
+		dc = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSource_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dName, "instances.0.id", "huaweicloud_er_instance.test", "id"),
+					resource.TestCheckResourceAttr(dName, "instances.0.name", name),
+					resource.TestCheckResourceAttr(dName, "instances.0.asn", fmt.Sprint(bgpAsNum)),
+					resource.TestCheckResourceAttrSet(dName, "instances.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstancesDataSource_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = ["%[1]s"]
+
+  name = "%[2]s"
+  asn  = %[3]d
+}
+
+data "huaweicloud_er_instances" "test" {
+  instance_id = huaweicloud_er_instance.test.id
+  name        = huaweicloud_er_instance.test.name
+}
+`, acceptance.HW_AVAILABILITY_ZONE, name, bgpAsNum)
+}
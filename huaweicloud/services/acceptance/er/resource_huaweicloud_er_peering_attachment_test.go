@@ -0,0 +1,135 @@
+package er
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getPeeringAttachmentResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.ErV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	path := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/peering-attachments/{id}"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{er_id}", state.Primary.Attributes["instance_id"])
+	path = strings.ReplaceAll(path, "{id}", state.Primary.ID)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccPeeringAttachment_basic(t *testing.T) {
+	var (
+		obj        interface{}
+		rName      = "huaweicloud_er_peering_attachment.test"
+		name       = acceptance.RandomAccResourceName()
+		updateName = acceptance.RandomAccResourceName()
+		bgpAsNum   = acctest.RandIntRange(64512, 65000)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPeeringAttachmentResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testPeeringAttachment_basic(name, name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "peer_instance_id", "huaweicloud_er_instance.peer", "id"),
+					resource.TestCheckResourceAttr(rName, "peer_region", acceptance.HW_REGION_NAME),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Create by acc test"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+				),
+			},
+			{
+				Config: testPeeringAttachment_basic(name, updateName, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPeeringAttachmentImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccPeeringAttachmentImportStateFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		instanceId := rs.Primary.Attributes["instance_id"]
+		if instanceId == "" || rs.Primary.ID == "" {
+			return "", fmt.Errorf("some import IDs are missing, want '<instance_id>/<id>', but '%s/%s'",
+				instanceId, rs.Primary.ID)
+		}
+		return fmt.Sprintf("%s/%s", instanceId, rs.Primary.ID), nil
+	}
+}
+
+func testPeeringAttachment_basic(name, attachmentName string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = ["%[1]s"]
+
+  name = "%[2]s"
+  asn  = %[3]d
+}
+
+resource "huaweicloud_er_instance" "peer" {
+  availability_zones = ["%[1]s"]
+
+  name = "%[2]s-peer"
+  asn  = %[3]d + 1
+}
+
+resource "huaweicloud_er_peering_attachment" "test" {
+  instance_id      = huaweicloud_er_instance.test.id
+  peer_instance_id = huaweicloud_er_instance.peer.id
+  peer_region      = "%[4]s"
+  name             = "%[5]s"
+  description      = "Create by acc test"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, acceptance.HW_AVAILABILITY_ZONE, name, bgpAsNum, acceptance.HW_REGION_NAME, attachmentName)
+}
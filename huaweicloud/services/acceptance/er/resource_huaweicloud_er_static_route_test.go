@@ -0,0 +1,147 @@
+package er
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getStaticRouteResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.ErV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	path := client.Endpoint + "v3/{project_id}/enterprise-router/route-tables/{route_table_id}/static-routes/{id}"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{route_table_id}", state.Primary.Attributes["route_table_id"])
+	path = strings.ReplaceAll(path, "{id}", state.Primary.ID)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccStaticRoute_basic(t *testing.T) {
+	var (
+		obj      interface{}
+		rName    = "huaweicloud_er_static_route.test"
+		name     = acceptance.RandomAccResourceName()
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getStaticRouteResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testStaticRoute_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "route_table_id", "huaweicloud_er_route_table.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "attachment_id", "huaweicloud_er_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttr(rName, "destination", "172.16.0.0/16"),
+					resource.TestCheckResourceAttr(rName, "is_blackhole", "false"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testStaticRoute_blackhole(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "attachment_id", ""),
+					resource.TestCheckResourceAttr(rName, "is_blackhole", "true"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStaticRouteImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccStaticRouteImportStateFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		routeTableId := rs.Primary.Attributes["route_table_id"]
+		if routeTableId == "" || rs.Primary.ID == "" {
+			return "", fmt.Errorf("some import IDs are missing, want '<route_table_id>/<id>', but '%s/%s'",
+				routeTableId, rs.Primary.ID)
+		}
+		return fmt.Sprintf("%s/%s", routeTableId, rs.Primary.ID), nil
+	}
+}
+
+func testStaticRoute_base(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_vpc_attachment" "test" {
+  instance_id = huaweicloud_er_instance.test.id
+  vpc_id      = huaweicloud_vpc.test.id
+  subnet_id   = huaweicloud_vpc_subnet.test.id
+  name        = "%[2]s"
+}
+
+resource "huaweicloud_er_route_table" "test" {
+  instance_id = huaweicloud_er_instance.test.id
+  name        = "%[2]s"
+}
+`, testVpcAttachment_base(name, bgpAsNum), name)
+}
+
+func testStaticRoute_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_static_route" "test" {
+  route_table_id = huaweicloud_er_route_table.test.id
+  destination    = "172.16.0.0/16"
+  attachment_id  = huaweicloud_er_vpc_attachment.test.id
+}
+`, testStaticRoute_base(name, bgpAsNum))
+}
+
+func testStaticRoute_blackhole(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_static_route" "test" {
+  route_table_id = huaweicloud_er_route_table.test.id
+  destination    = "172.16.0.0/16"
+  is_blackhole   = true
+}
+`, testStaticRoute_base(name, bgpAsNum))
+}
@@ -0,0 +1,115 @@
+package fgs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/fgs/v2/function"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getFunctionAliasFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.FgsV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+	return function.GetAlias(c, state.Primary.Attributes["function_urn"], state.Primary.Attributes["name"]).ExtractAlias()
+}
+
+func TestAccFunctionAlias_basic(t *testing.T) {
+	var alias function.AliasResult
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_fgs_function_alias.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&alias,
+		getFunctionAliasFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionAlias_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", "prod"),
+					resource.TestCheckResourceAttr(rName, "version", "v1"),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acceptance test"),
+					resource.TestCheckResourceAttr(rName, "additional_version_weights.%", "0"),
+					resource.TestCheckResourceAttrSet(rName, "urn"),
+				),
+			},
+			{
+				Config: testAccFunctionAlias_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "version", "v1"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "additional_version_weights.%", "1"),
+					resource.TestCheckResourceAttr(rName, "additional_version_weights.v2", "20"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFunctionAlias_base(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_fgs_function_version" "v1" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  version      = "v1"
+}
+
+resource "huaweicloud_fgs_function_version" "v2" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  version      = "v2"
+
+  depends_on = [huaweicloud_fgs_function_version.v1]
+}
+`, testAccFunction_base(name))
+}
+
+func testAccFunctionAlias_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_fgs_function_alias" "test" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  name         = "prod"
+  version      = huaweicloud_fgs_function_version.v1.version
+  description  = "Created by acceptance test"
+}
+`, testAccFunctionAlias_base(name))
+}
+
+func testAccFunctionAlias_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_fgs_function_alias" "test" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  name         = "prod"
+  version      = huaweicloud_fgs_function_version.v1.version
+
+  additional_version_weights = {
+    (huaweicloud_fgs_function_version.v2.version) = 20
+  }
+}
+`, testAccFunctionAlias_base(name))
+}
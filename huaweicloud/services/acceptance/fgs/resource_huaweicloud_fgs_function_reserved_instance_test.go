@@ -0,0 +1,108 @@
+package fgs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getFunctionReservedInstanceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.FgsV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	path := c.Endpoint + "v2/{project_id}/fgs/functions/reservedinstanceconfigs?function_urn={function_urn}"
+	path = strings.ReplaceAll(path, "{project_id}", c.ProjectID)
+	path = strings.ReplaceAll(path, "{function_urn}", state.Primary.Attributes["function_urn"])
+	resp, err := c.Request("GET", path, &golangsdk.RequestOpts{KeepResponseBody: true, OkCodes: []int{200}})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("reserved_instances[?qualifier_name=='%s' && min_count > `0`]|[0]",
+		state.Primary.Attributes["qualifier_name"])
+	reservedConfig := utils.PathSearch(expression, respBody, nil)
+	if reservedConfig == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return reservedConfig, nil
+}
+
+func TestAccFunctionReservedInstance_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_fgs_function_reserved_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getFunctionReservedInstanceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionReservedInstance_basic(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "qualifier_type", "alias"),
+					resource.TestCheckResourceAttr(rName, "qualifier_name", "prod"),
+					resource.TestCheckResourceAttr(rName, "instance_count", "1"),
+				),
+			},
+			{
+				Config: testAccFunctionReservedInstance_basic(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "instance_count", "2"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFunctionReservedInstance_basic(name string, count int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_fgs_function_version" "test" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  version      = "v1"
+}
+
+resource "huaweicloud_fgs_function_alias" "test" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  name         = "prod"
+  version      = huaweicloud_fgs_function_version.test.version
+}
+
+resource "huaweicloud_fgs_function_reserved_instance" "test" {
+  function_urn   = huaweicloud_fgs_function.test.urn
+  qualifier_type = "alias"
+  qualifier_name = huaweicloud_fgs_function_alias.test.name
+  instance_count = %d
+}
+`, testAccFunction_base(name), count)
+}
@@ -56,6 +56,7 @@ func TestAccFgsV2Function_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "version"),
 					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "huaweicloud_vpc.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "network_id", "huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "max_instance_num", "100"),
 				),
 			},
 			{
@@ -261,18 +262,19 @@ func testAccFgsV2Function_update(rName string) string {
 %[1]s
 
 resource "huaweicloud_fgs_function" "test" {
-  name        = "%[2]s"
-  app         = "default"
-  description = "fuction test update"
-  handler     = "index.handler"
-  memory_size = 128
-  timeout     = 3
-  runtime     = "Python2.7"
-  code_type   = "inline"
-  func_code   = "aW1wb3J0IGpzb24KZGVmIGhhbmRsZXIgKGV2ZW50LCBjb250ZXh0KToKICAgIG91dHB1dCA9ICdIZWxsbyBtZXNzYWdlOiAnICsganNvbi5kdW1wcyhldmVudCkKICAgIHJldHVybiBvdXRwdXQ="
-  agency      = "function_vpc_trust"
-  vpc_id      = huaweicloud_vpc.test.id
-  network_id  = huaweicloud_vpc_subnet.test.id
+  name             = "%[2]s"
+  app              = "default"
+  description      = "fuction test update"
+  handler          = "index.handler"
+  memory_size      = 128
+  timeout          = 3
+  runtime          = "Python2.7"
+  code_type        = "inline"
+  func_code        = "aW1wb3J0IGpzb24KZGVmIGhhbmRsZXIgKGV2ZW50LCBjb250ZXh0KToKICAgIG91dHB1dCA9ICdIZWxsbyBtZXNzYWdlOiAnICsganNvbi5kdW1wcyhldmVudCkKICAgIHJldHVybiBvdXRwdXQ="
+  agency           = "function_vpc_trust"
+  vpc_id           = huaweicloud_vpc.test.id
+  network_id       = huaweicloud_vpc_subnet.test.id
+  max_instance_num = 100
 }
 `, common.TestBaseNetwork(rName), rName)
 }
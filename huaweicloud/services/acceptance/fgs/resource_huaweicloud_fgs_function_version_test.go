@@ -0,0 +1,86 @@
+package fgs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/fgs/v2/function"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getFunctionVersionFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.FgsV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+	return function.GetMetadata(c, state.Primary.ID).Extract()
+}
+
+func TestAccFunctionVersion_basic(t *testing.T) {
+	var f function.Function
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_fgs_function_version.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&f,
+		getFunctionVersionFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionVersion_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "function_urn",
+						"huaweicloud_fgs_function.test", "urn"),
+					resource.TestCheckResourceAttr(rName, "version", "v1"),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acceptance test"),
+					resource.TestCheckResourceAttrSet(rName, "digest"),
+					resource.TestCheckResourceAttrSet(rName, "urn"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFunction_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_fgs_function" "test" {
+  name        = "%s"
+  app         = "default"
+  handler     = "index.handler"
+  memory_size = 128
+  timeout     = 3
+  runtime     = "Python2.7"
+  code_type   = "inline"
+  func_code   = "aW1wb3J0IGpzb24KZGVmIGhhbmRsZXIgKGV2ZW50LCBjb250ZXh0KToKICAgIG91dHB1dCA9ICdIZWxsbyBtZXNzYWdlOiAnICsganNvbi5kdW1wcyhldmVudCkKICAgIHJldHVybiBvdXRwdXQ="
+}
+`, name)
+}
+
+func testAccFunctionVersion_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_fgs_function_version" "test" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  version      = "v1"
+  description  = "Created by acceptance test"
+}
+`, testAccFunction_base(name))
+}
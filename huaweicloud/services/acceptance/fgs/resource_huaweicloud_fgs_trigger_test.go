@@ -17,8 +17,11 @@ func getTriggerResourceFunc(conf *config.Config, state *terraform.ResourceState)
 	if err != nil {
 		return nil, fmt.Errorf("error creating HuaweiCloud FunctionGraph v2 client: %s", err)
 	}
-	return trigger.Get(c, state.Primary.Attributes["function_urn"], state.Primary.Attributes["type"],
-		state.Primary.ID).Extract()
+	urn := state.Primary.Attributes["function_urn"]
+	if alias := state.Primary.Attributes["function_alias"]; alias != "" {
+		urn = fmt.Sprintf("%s:%s", urn, alias)
+	}
+	return trigger.Get(c, urn, state.Primary.Attributes["type"], state.Primary.ID).Extract()
 }
 
 func TestAccFunctionGraphTrigger_basic(t *testing.T) {
@@ -69,6 +72,37 @@ func TestAccFunctionGraphTrigger_basic(t *testing.T) {
 	})
 }
 
+func TestAccFunctionGraphTrigger_alias(t *testing.T) {
+	var (
+		timeTrigger  trigger.Trigger
+		randName     = acceptance.RandomAccResourceName()
+		resourceName = "huaweicloud_fgs_trigger.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&timeTrigger,
+		getTriggerResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionGraphTimingTrigger_alias(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "TIMER"),
+					resource.TestCheckResourceAttr(resourceName, "function_alias", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFunctionGraphTrigger_cronTimer(t *testing.T) {
 	var (
 		randName     = acceptance.RandomAccResourceName()
@@ -229,6 +263,35 @@ resource "huaweicloud_fgs_trigger" "test" {
 `, testAccFunctionGraphTimingTrigger_base(rName), rName)
 }
 
+func testAccFunctionGraphTimingTrigger_alias(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_fgs_function_version" "test" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  version      = "v1"
+}
+
+resource "huaweicloud_fgs_function_alias" "test" {
+  function_urn = huaweicloud_fgs_function.test.urn
+  name         = "prod"
+  version      = huaweicloud_fgs_function_version.test.version
+}
+
+resource "huaweicloud_fgs_trigger" "test" {
+  function_urn   = huaweicloud_fgs_function.test.urn
+  function_alias = huaweicloud_fgs_function_alias.test.name
+  type           = "TIMER"
+
+  timer {
+    name          = "%s"
+    schedule_type = "Rate"
+    schedule      = "3d"
+  }
+}
+`, testAccFunctionGraphTimingTrigger_base(rName), rName)
+}
+
 func testAccFunctionGraphTimingTrigger_cron(rName string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,126 @@
+package obs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getOBSBucketInventoryResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <bucket>/<configuration_id>")
+	}
+	return cfg.ObjectStorageBucketRequest(region, "GET", parts[0],
+		map[string]string{"inventory": "", "id": parts[1]}, "", nil)
+}
+
+func TestAccObsBucketInventory_basic(t *testing.T) {
+	var obj interface{}
+
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_obs_bucket_inventory.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getOBSBucketInventoryResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketInventory_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(rName, "configuration_id", "terraform-test"),
+					resource.TestCheckResourceAttr(rName, "destination_bucket", bucketName+"-dest"),
+					resource.TestCheckResourceAttr(rName, "destination_prefix", "inventory/"),
+					resource.TestCheckResourceAttr(rName, "frequency", "Daily"),
+					resource.TestCheckResourceAttr(rName, "included_object_versions", "Current"),
+					resource.TestCheckResourceAttr(rName, "filter_prefix", "logs/"),
+					resource.TestCheckResourceAttr(rName, "optional_fields.#", "2"),
+					resource.TestCheckResourceAttr(rName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccObsBucketInventory_update(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "destination_prefix", ""),
+					resource.TestCheckResourceAttr(rName, "frequency", "Weekly"),
+					resource.TestCheckResourceAttr(rName, "included_object_versions", "All"),
+					resource.TestCheckResourceAttr(rName, "filter_prefix", ""),
+					resource.TestCheckResourceAttr(rName, "optional_fields.#", "0"),
+					resource.TestCheckResourceAttr(rName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketInventory_base(bucketName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "source" {
+  bucket        = "%[1]s"
+  storage_class = "STANDARD"
+  acl           = "private"
+}
+
+resource "huaweicloud_obs_bucket" "destination" {
+  bucket        = "%[1]s-dest"
+  storage_class = "STANDARD"
+  acl           = "private"
+  force_destroy = true
+}
+`, bucketName)
+}
+
+func testAccObsBucketInventory_basic(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket_inventory" "test" {
+  bucket             = huaweicloud_obs_bucket.source.bucket
+  configuration_id   = "terraform-test"
+  destination_bucket = huaweicloud_obs_bucket.destination.bucket
+  destination_prefix = "inventory/"
+  frequency          = "Daily"
+  filter_prefix      = "logs/"
+  optional_fields    = ["Size", "StorageClass"]
+}
+`, testAccObsBucketInventory_base(bucketName))
+}
+
+func testAccObsBucketInventory_update(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket_inventory" "test" {
+  bucket                   = huaweicloud_obs_bucket.source.bucket
+  configuration_id         = "terraform-test"
+  destination_bucket       = huaweicloud_obs_bucket.destination.bucket
+  frequency                = "Weekly"
+  included_object_versions = "All"
+  enabled                  = false
+}
+`, testAccObsBucketInventory_base(bucketName))
+}
@@ -0,0 +1,107 @@
+package obs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getOBSBucketMirrorBackToSourceResourceFunc(cfg *config.Config,
+	state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	return cfg.ObjectStorageBucketRequest(region, "GET", state.Primary.ID,
+		map[string]string{"mirrorBackToSource": ""}, "", nil)
+}
+
+func TestAccObsBucketMirrorBackToSource_basic(t *testing.T) {
+	var obj interface{}
+
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+	agencyName := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_obs_bucket_mirror_back_to_source.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getOBSBucketMirrorBackToSourceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketMirrorBackToSource_basic(agencyName, bucketName, "images/"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", bucketName),
+					resource.TestCheckResourceAttrSet(rName, "rules"),
+				),
+			},
+			{
+				Config: testAccObsBucketMirrorBackToSource_basic(agencyName, bucketName, "docs/"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(rName, "rules"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketMirrorBackToSource_basic(agencyName, bucketName, prefix string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_identity_agency" "test" {
+  name                   = "%[1]s"
+  description            = "This is an iam agency for obs mirror back to source"
+  delegated_service_name = "op_svc_obs"
+
+  domain_roles = [
+    "OBS Administrator",
+  ]
+}
+
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[2]s"
+  storage_class = "STANDARD"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_obs_bucket_mirror_back_to_source" "test" {
+  bucket = huaweicloud_obs_bucket.test.bucket
+  rules = jsonencode([
+    {
+      id = "terraform-test"
+      condition = {
+        httpErrorCodeReturnedEquals = "404"
+        objectKeyPrefixEquals       = "%[3]s"
+      }
+      redirect = {
+        agency = huaweicloud_identity_agency.test.name
+        publicSource = {
+          sourceEndpoint = {
+            master = ["https://www.example.com"]
+          }
+        }
+        retryConditions = ["4XX"]
+        passQueryString = true
+      }
+    }
+  ])
+}
+`, agencyName, bucketName, prefix)
+}
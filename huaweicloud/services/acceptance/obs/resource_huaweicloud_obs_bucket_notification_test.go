@@ -0,0 +1,127 @@
+package obs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getOBSBucketNotificationResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	body, err := cfg.ObjectStorageBucketRequest(region, "GET", state.Primary.ID,
+		map[string]string{"notification": ""}, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	// an empty configuration means the notification has been deleted
+	if !strings.Contains(string(body), "Configuration>") {
+		return nil, fmt.Errorf("the notification configuration of OBS bucket %s is empty", state.Primary.ID)
+	}
+	return body, nil
+}
+
+func TestAccObsBucketNotification_basic(t *testing.T) {
+	var obj interface{}
+
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+	topicName := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_obs_bucket_notification.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getOBSBucketNotificationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketNotification_basic(bucketName, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.#", "1"),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.0.events.#", "1"),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.0.prefix", "logs/"),
+					resource.TestCheckResourceAttrPair(rName, "topic_configurations.0.topic_urn",
+						"huaweicloud_smn_topic.test", "topic_urn"),
+					resource.TestCheckResourceAttrSet(rName, "topic_configurations.0.id"),
+				),
+			},
+			{
+				Config: testAccObsBucketNotification_update(bucketName, topicName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.#", "1"),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.0.id", "terraform-test"),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.0.events.#", "2"),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.0.prefix", ""),
+					resource.TestCheckResourceAttr(rName, "topic_configurations.0.suffix", ".jpg"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketNotification_base(bucketName, topicName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  storage_class = "STANDARD"
+  acl           = "private"
+}
+
+resource "huaweicloud_smn_topic" "test" {
+  name = "%[2]s"
+}
+`, bucketName, topicName)
+}
+
+func testAccObsBucketNotification_basic(bucketName, topicName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket_notification" "test" {
+  bucket = huaweicloud_obs_bucket.test.bucket
+
+  topic_configurations {
+    topic_urn = huaweicloud_smn_topic.test.topic_urn
+    events    = ["ObjectCreated:*"]
+    prefix    = "logs/"
+  }
+}
+`, testAccObsBucketNotification_base(bucketName, topicName))
+}
+
+func testAccObsBucketNotification_update(bucketName, topicName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket_notification" "test" {
+  bucket = huaweicloud_obs_bucket.test.bucket
+
+  topic_configurations {
+    id        = "terraform-test"
+    topic_urn = huaweicloud_smn_topic.test.topic_urn
+    events    = ["ObjectCreated:Put", "ObjectRemoved:Delete"]
+    suffix    = ".jpg"
+  }
+}
+`, testAccObsBucketNotification_base(bucketName, topicName))
+}
@@ -0,0 +1,113 @@
+package obs
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getOBSBucketObjectLockResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	body, err := cfg.ObjectStorageBucketRequest(region, "GET", state.Primary.ID,
+		map[string]string{"object-lock": ""}, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var configuration struct {
+		Mode string `xml:"Rule>DefaultRetention>Mode"`
+	}
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return nil, err
+	}
+	// WORM cannot be disabled, so the resource is deleted if the default retention is empty
+	if configuration.Mode == "" {
+		return nil, fmt.Errorf("the default WORM retention of OBS bucket %s is empty", state.Primary.ID)
+	}
+	return configuration, nil
+}
+
+func TestAccObsBucketObjectLock_basic(t *testing.T) {
+	var obj interface{}
+
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_obs_bucket_object_lock.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getOBSBucketObjectLockResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectLock_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(rName, "mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr(rName, "days", "1"),
+					resource.TestCheckResourceAttr(rName, "years", "0"),
+				),
+			},
+			{
+				Config: testAccObsBucketObjectLock_update(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "days", "0"),
+					resource.TestCheckResourceAttr(rName, "years", "1"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketObjectLock_base(bucketName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%s"
+  storage_class = "STANDARD"
+  acl           = "private"
+  force_destroy = true
+}
+`, bucketName)
+}
+
+func testAccObsBucketObjectLock_basic(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket_object_lock" "test" {
+  bucket = huaweicloud_obs_bucket.test.bucket
+  days   = 1
+}
+`, testAccObsBucketObjectLock_base(bucketName))
+}
+
+func testAccObsBucketObjectLock_update(bucketName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket_object_lock" "test" {
+  bucket = huaweicloud_obs_bucket.test.bucket
+  years  = 1
+}
+`, testAccObsBucketObjectLock_base(bucketName))
+}
@@ -0,0 +1,132 @@
+package obs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getOBSBucketObjectsSyncResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	obsClient, err := cfg.ObjectStorageClient(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	input := &obs.ListObjectsInput{
+		Bucket: state.Primary.Attributes["bucket"],
+	}
+	input.Prefix = state.Primary.Attributes["key_prefix"]
+	resp, err := obsClient.ListObjects(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Contents) == 0 {
+		return nil, fmt.Errorf("no objects found with the prefix %s", input.Prefix)
+	}
+	return resp, nil
+}
+
+func writeSyncTestFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAccObsBucketObjectsSync_basic(t *testing.T) {
+	var obj interface{}
+
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+	sourceDir := t.TempDir()
+	rName := "huaweicloud_obs_bucket_objects_sync.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getOBSBucketObjectsSyncResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+			writeSyncTestFiles(t, sourceDir, map[string]string{
+				"index.html":      "<html></html>",
+				"css/main.css":    "body {}",
+				"js/app.js":       "console.log('app')",
+				"js/app.js.map":   "{}",
+				"data/config.dat": "config",
+			})
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectsSync_basic(bucketName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(rName, "files.%", "4"),
+					resource.TestCheckResourceAttr(rName, "etags.%", "4"),
+					resource.TestCheckResourceAttrSet(rName, "files.site/index.html"),
+					resource.TestCheckNoResourceAttr(rName, "files.site/js/app.js.map"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeSyncTestFiles(t, sourceDir, map[string]string{
+						"index.html": "<html><body></body></html>",
+					})
+					if err := os.RemoveAll(filepath.Join(sourceDir, "data")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObsBucketObjectsSync_basic(bucketName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "files.%", "3"),
+					resource.TestCheckResourceAttr(rName, "files.site/index.html",
+						"b256d97fbb697428b7a1286ea33539c0"),
+					resource.TestCheckNoResourceAttr(rName, "files.site/data/config.dat"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObsBucketObjectsSync_basic(bucketName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  storage_class = "STANDARD"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_obs_bucket_objects_sync" "test" {
+  bucket            = huaweicloud_obs_bucket.test.bucket
+  source_dir        = "%[2]s"
+  key_prefix        = "site/"
+  exclude           = ["**/*.map"]
+  delete_extraneous = true
+  part_size         = 5
+
+  content_types = {
+    ".dat" = "text/plain"
+  }
+}
+`, bucketName, filepath.ToSlash(sourceDir))
+}
@@ -285,6 +285,35 @@ func TestAccRdsInstance_withParameters(t *testing.T) {
 	})
 }
 
+func TestAccRdsInstance_restore(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := acceptance.RandomAccResourceName()
+	resourceType := "huaweicloud_rds_instance"
+	resourceName := "huaweicloud_rds_instance.restored"
+	pwd := fmt.Sprintf("%s%s%d", acctest.RandString(5), acctest.RandStringFromCharSet(2, "!#%^*"),
+		acctest.RandIntRange(10, 99))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstance_restore(name, pwd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-restored"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.source_instance_id",
+						"huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.backup_id",
+						"huaweicloud_rds_backup.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRdsInstanceDestroy(rsType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := acceptance.TestAccProvider.Meta().(*config.Config)
@@ -769,3 +798,39 @@ resource "huaweicloud_rds_instance" "test" {
 }
 `, common.TestBaseNetwork(name), name)
 }
+
+func testAccRdsInstance_restore(name, pwd string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_rds_backup" "test" {
+  name        = "%[2]s"
+  instance_id = huaweicloud_rds_instance.test.id
+}
+
+resource "huaweicloud_rds_instance" "restored" {
+  name              = "%[2]s-restored"
+  flavor            = data.huaweicloud_rds_flavors.test.flavors[0].name
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+  availability_zone = slice(sort(data.huaweicloud_rds_flavors.test.flavors[0].availability_zones), 0, 1)
+
+  db {
+    password = "%[3]s"
+    type     = "MySQL"
+    version  = "8.0"
+  }
+
+  volume {
+    type = "CLOUDSSD"
+    size = 40
+  }
+
+  restore {
+    source_instance_id = huaweicloud_rds_instance.test.id
+    backup_id          = huaweicloud_rds_backup.test.id
+  }
+}
+`, testAccRdsInstance_mysql_step1(name, pwd), name, pwd)
+}
@@ -0,0 +1,133 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+func getPgAccountFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	return rds.QueryPgAccount(client, parts[0], parts[1])
+}
+
+func TestAccPgAccount_basic(t *testing.T) {
+	var user model.PostgresqlUserForList
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_pg_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&user,
+		getPgAccountFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testPgAccount_basic(name, "Test@12345678"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "attributes.0.rolcanlogin", "true"),
+					resource.TestCheckResourceAttr(rName, "attributes.0.rolsuper", "false"),
+				),
+			},
+			{
+				Config: testPgAccount_update(name, "Test@123456789"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "password", "Test@123456789"),
+					resource.TestCheckResourceAttr(rName, "attributes.0.rolcreatedb", "true"),
+					resource.TestCheckResourceAttr(rName, "attributes.0.rolcreaterole", "true"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccRdsPgInstance_base(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_rds_instance" "test" {
+  name              = "%s"
+  flavor            = "rds.pg.n1.large.2"
+  availability_zone = [data.huaweicloud_availability_zones.test.names[0]]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+    port     = 8635
+  }
+
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+}
+`, common.TestBaseNetwork(name), name)
+}
+
+func testPgAccount_basic(name, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_account" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "%s"
+  password    = "%s"
+}
+`, testAccRdsPgInstance_base(name), name, password)
+}
+
+func testPgAccount_update(name, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_account" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "%s"
+  password    = "%s"
+
+  attributes {
+    rolcreatedb   = true
+    rolcreaterole = true
+  }
+}
+`, testAccRdsPgInstance_base(name), name, password)
+}
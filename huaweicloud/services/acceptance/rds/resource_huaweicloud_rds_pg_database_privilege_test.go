@@ -0,0 +1,55 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccPgDatabasePrivilege_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_pg_database_privilege.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testPgDatabasePrivilege_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "db_name", "huaweicloud_rds_pg_database.test", "name"),
+					resource.TestCheckResourceAttr(rName, "users.#", "1"),
+					resource.TestCheckResourceAttr(rName, "users.0.name", name+"_reader"),
+					resource.TestCheckResourceAttr(rName, "users.0.schema_name", name),
+					resource.TestCheckResourceAttr(rName, "users.0.readonly", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testPgDatabasePrivilege_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_account" "reader" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "%s_reader"
+  password    = "Test@12345678"
+}
+
+resource "huaweicloud_rds_pg_database_privilege" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  db_name     = huaweicloud_rds_pg_database.test.name
+
+  users {
+    name        = huaweicloud_rds_pg_account.reader.name
+    schema_name = huaweicloud_rds_pg_schema.test.schema_name
+    readonly    = true
+  }
+}
+`, testPgSchema_basic(name), name)
+}
@@ -0,0 +1,83 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+func getPgDatabaseFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	return rds.QueryPgDatabase(client, parts[0], parts[1])
+}
+
+func TestAccPgDatabase_basic(t *testing.T) {
+	var database model.PostgresqlListDatabase
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_pg_database.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&database,
+		getPgDatabaseFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testPgDatabase_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrPair(rName, "owner", "huaweicloud_rds_pg_account.test", "name"),
+					resource.TestCheckResourceAttr(rName, "character_set", "UTF8"),
+					resource.TestCheckResourceAttr(rName, "lc_collate", "en_US.UTF-8"),
+					resource.TestCheckResourceAttrSet(rName, "size"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template", "lc_ctype", "is_revoke_public_privilege"},
+			},
+		},
+	})
+}
+
+func testPgDatabase_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_database" "test" {
+  instance_id                = huaweicloud_rds_instance.test.id
+  name                       = "%s"
+  owner                      = huaweicloud_rds_pg_account.test.name
+  character_set              = "UTF8"
+  template                   = "template0"
+  lc_collate                 = "en_US.UTF-8"
+  lc_ctype                   = "en_US.UTF-8"
+  is_revoke_public_privilege = true
+}
+`, testPgAccount_basic(name, "Test@12345678"), name)
+}
@@ -0,0 +1,76 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+func getPgSchemaFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id, database and schema from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<database_name>/<schema_name>")
+	}
+	return rds.QueryPgSchema(client, parts[0], parts[1], parts[2])
+}
+
+func TestAccPgSchema_basic(t *testing.T) {
+	var pgSchema model.PostgresqlDatabaseForListSchema
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_pg_schema.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&pgSchema,
+		getPgSchemaFunc,
+	)
+
+	// the schema cannot be deleted, it is removed together with the database
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testPgSchema_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "schema_name", name),
+					resource.TestCheckResourceAttrPair(rName, "db_name", "huaweicloud_rds_pg_database.test", "name"),
+					resource.TestCheckResourceAttrPair(rName, "owner", "huaweicloud_rds_pg_account.test", "name"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPgSchema_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_schema" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  db_name     = huaweicloud_rds_pg_database.test.name
+  schema_name = "%s"
+  owner       = huaweicloud_rds_pg_account.test.name
+}
+`, testPgDatabase_basic(name), name)
+}
@@ -0,0 +1,79 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+func getSQLServerDatabasePrivilegeFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	return rds.QuerySQLServerDatabaseUsers(client, parts[0], parts[1])
+}
+
+func TestAccSQLServerDatabasePrivilege_basic(t *testing.T) {
+	var users []model.UserWithPrivilege
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_sqlserver_database_privilege.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&users,
+		getSQLServerDatabasePrivilegeFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testSQLServerDatabasePrivilege_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "users.0.name",
+						"huaweicloud_rds_sqlserver_account.test", "name"),
+					resource.TestCheckResourceAttr(rName, "users.0.readonly", "true"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testSQLServerDatabasePrivilege_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_sqlserver_database_privilege" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  db_name     = huaweicloud_rds_sqlserver_database.test.name
+
+  users {
+    name     = huaweicloud_rds_sqlserver_account.test.name
+    readonly = true
+  }
+}
+`, testSQLServerDatabase_basic(name))
+}
@@ -0,0 +1,74 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+func getSQLServerDatabaseFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	return rds.QuerySQLServerDatabase(client, parts[0], parts[1])
+}
+
+func TestAccSQLServerDatabase_basic(t *testing.T) {
+	var database model.SqlserverDatabaseForDetail
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_sqlserver_database.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&database,
+		getSQLServerDatabaseFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testSQLServerDatabase_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrSet(rName, "character_set"),
+					resource.TestCheckResourceAttr(rName, "status", "Running"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testSQLServerDatabase_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_sqlserver_database" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "%s"
+}
+`, testSQLServerAccount_basic(name, "Test@12345678"), name)
+}
@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance/common"
+)
+
+func TestAccDataRestAPI_basic(t *testing.T) {
+	var (
+		name  = acceptance.RandomAccResourceName()
+		dName = "data.huaweicloud_rest_api.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataRestAPI_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "status_code", "200"),
+					resource.TestCheckResourceAttr(dName, "result", fmt.Sprintf(`["%s"]`, name)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataRestAPI_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "huaweicloud_rest_api" "test" {
+  service = "vpc"
+  path    = "v1/{project_id}/vpcs"
+  output  = "vpcs[?id=='${huaweicloud_vpc.test.id}'].name"
+
+  query = {
+    limit = "2000"
+  }
+}
+`, common.TestVpc(name))
+}
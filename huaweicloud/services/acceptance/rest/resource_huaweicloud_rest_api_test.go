@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/networking/v1/vpcs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRestAPIVpcResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NetworkingV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC v1 client: %s", err)
+	}
+
+	return vpcs.Get(client, state.Primary.ID).Extract()
+}
+
+func TestAccRestAPI_basic(t *testing.T) {
+	var (
+		obj vpcs.Vpc
+
+		rName      = "huaweicloud_rest_api.test"
+		name       = acceptance.RandomAccResourceName()
+		updateName = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRestAPIVpcResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPI_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "result", fmt.Sprintf(`"%s"`, name)),
+					resource.TestCheckResourceAttrSet(rName, "response"),
+				),
+			},
+			{
+				Config: testAccRestAPI_basic(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "result", fmt.Sprintf(`"%s"`, updateName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccRestAPI_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_rest_api" "test" {
+  service = "vpc"
+  output  = "vpc.name"
+
+  create {
+    path          = "v1/{project_id}/vpcs"
+    id_expression = "vpc.id"
+    body          = jsonencode({
+      vpc = {
+        name = "%[1]s"
+        cidr = "192.168.0.0/16"
+      }
+    })
+
+    wait {
+      status_expression = "vpc.status"
+      pending           = ["CREATING"]
+      target            = ["OK"]
+      interval          = 5
+    }
+  }
+
+  read {
+    path = "v1/{project_id}/vpcs/{id}"
+  }
+
+  update {
+    path = "v1/{project_id}/vpcs/{id}"
+    body = jsonencode({
+      vpc = {
+        name = "%[1]s"
+      }
+    })
+  }
+
+  delete {
+    path = "v1/{project_id}/vpcs/{id}"
+
+    wait {
+      status_expression = "vpc.status"
+      pending           = ["OK"]
+      target            = ["deleted"]
+      interval          = 5
+    }
+  }
+}
+`, name)
+}
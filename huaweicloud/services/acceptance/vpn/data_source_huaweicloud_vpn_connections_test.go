@@ -0,0 +1,48 @@
+package vpn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceConnections_basic(t *testing.T) {
+	var (
+		name  = acceptance.RandomAccResourceName()
+		dName = "data.huaweicloud_vpn_connections.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnections_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "connections.#", "1"),
+					resource.TestCheckResourceAttrPair(dName, "connections.0.id", "huaweicloud_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttr(dName, "connections.0.name", name),
+					resource.TestCheckResourceAttr(dName, "connections.0.vpn_type", "static"),
+					resource.TestCheckResourceAttrSet(dName, "connections.0.status"),
+					resource.TestCheckResourceAttrSet(dName, "connections.0.ikepolicy.0.ike_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConnections_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpn_connections" "test" {
+  gateway_id    = huaweicloud_vpn_gateway.test.id
+  connection_id = huaweicloud_vpn_connection.test.id
+}
+`, testConnection_basic(name))
+}
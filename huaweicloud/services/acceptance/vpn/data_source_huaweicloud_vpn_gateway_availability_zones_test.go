@@ -0,0 +1,38 @@
+package vpn
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceGatewayAvailabilityZones_basic(t *testing.T) {
+	var (
+		dName = "data.huaweicloud_vpn_gateway_availability_zones.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGatewayAvailabilityZones_basic,
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dName, "names.#"),
+					resource.TestCheckResourceAttrSet(dName, "names.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceGatewayAvailabilityZones_basic = `
+data "huaweicloud_vpn_gateway_availability_zones" "test" {
+  flavor          = "Professional1"
+  attachment_type = "vpc"
+}
+`
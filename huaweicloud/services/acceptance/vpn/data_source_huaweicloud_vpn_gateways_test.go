@@ -0,0 +1,47 @@
+package vpn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceGateways_basic(t *testing.T) {
+	var (
+		name  = acceptance.RandomAccResourceName()
+		dName = "data.huaweicloud_vpn_gateways.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGateways_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "gateways.#", "1"),
+					resource.TestCheckResourceAttrPair(dName, "gateways.0.id", "huaweicloud_vpn_gateway.test", "id"),
+					resource.TestCheckResourceAttr(dName, "gateways.0.name", name),
+					resource.TestCheckResourceAttr(dName, "gateways.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dName, "gateways.0.master_eip.0.id",
+						"huaweicloud_vpc_eip.test1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGateways_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpn_gateways" "test" {
+  gateway_id = huaweicloud_vpn_gateway.test.id
+}
+`, testGateway_basic(name))
+}
@@ -0,0 +1,124 @@
+package vpn
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getGatewayCertificateResourceFunc(config *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getGatewayCertificate: Query the certificate of the VPN gateway
+	var (
+		getGatewayCertificateHttpUrl = "v5/{project_id}/vpn-gateways/{vgw_id}/certificate"
+		getGatewayCertificateProduct = "vpn"
+	)
+	getGatewayCertificateClient, err := config.NewServiceClient(getGatewayCertificateProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPN Client: %s", err)
+	}
+
+	getGatewayCertificatePath := getGatewayCertificateClient.Endpoint + getGatewayCertificateHttpUrl
+	getGatewayCertificatePath = strings.ReplaceAll(getGatewayCertificatePath, "{project_id}",
+		getGatewayCertificateClient.ProjectID)
+	getGatewayCertificatePath = strings.ReplaceAll(getGatewayCertificatePath, "{vgw_id}",
+		state.Primary.Attributes["vpn_gateway_id"])
+
+	getGatewayCertificateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getGatewayCertificateResp, err := getGatewayCertificateClient.Request("GET", getGatewayCertificatePath,
+		&getGatewayCertificateOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving GatewayCertificate: %s", err)
+	}
+	return utils.FlattenResponse(getGatewayCertificateResp)
+}
+
+func TestAccGatewayCertificate_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_vpn_gateway_certificate.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGatewayCertificateResourceFunc,
+	)
+
+	// The gateway certificate can not be deleted, so there is no destroy check.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckVpnGatewayCertificate(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testGatewayCertificate_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "vpn_gateway_id", acceptance.HW_VPN_GATEWAY_ID),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttrSet(rName, "certificate_expire_time"),
+					resource.TestCheckResourceAttrSet(rName, "enc_certificate_expire_time"),
+				),
+			},
+			{
+				Config: testGatewayCertificate_basic(name + "-update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-update"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testGatewayCertificateImportState(rName),
+				ImportStateVerifyIgnore: []string{
+					"certificate", "private_key", "certificate_chain", "enc_certificate", "enc_private_key",
+				},
+			},
+		},
+	})
+}
+
+func testGatewayCertificateImportState(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["vpn_gateway_id"], rs.Primary.ID), nil
+	}
+}
+
+func testGatewayCertificate_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpn_gateway_certificate" "test" {
+  vpn_gateway_id    = "%s"
+  name              = "%s"
+  certificate       = file("%s")
+  private_key       = file("%s")
+  certificate_chain = file("%s")
+  enc_certificate   = file("%s")
+  enc_private_key   = file("%s")
+}
+`, acceptance.HW_VPN_GATEWAY_ID, name, acceptance.HW_VPN_CERTIFICATE_PATH, acceptance.HW_VPN_CERTIFICATE_KEY_PATH,
+		acceptance.HW_VPN_CERTIFICATE_CHAIN_PATH, acceptance.HW_VPN_ENC_CERTIFICATE_PATH,
+		acceptance.HW_VPN_ENC_CERTIFICATE_KEY_PATH)
+}
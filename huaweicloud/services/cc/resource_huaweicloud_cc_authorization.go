@@ -0,0 +1,303 @@
+package cc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/jmespath/go-jmespath"
+)
+
+func ResourceAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthorizationCreate,
+		UpdateContext: resourceAuthorizationUpdate,
+		ReadContext:   resourceAuthorizationRead,
+		DeleteContext: resourceAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "vpc",
+				Description: `Type of the network instance to be authorized.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc",
+				}, false),
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `ID of the network instance to be authorized.`,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Project ID of the network instance.`,
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Region ID of the network instance.`,
+			},
+			"cloud_connection_domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Account ID of the peer cloud connection.`,
+			},
+			"cloud_connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Peer cloud connection ID.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The authorization name.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[\x{4E00}-\x{9FFC}A-Za-z-_0-9.]*$`),
+						"the input is invalid"),
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description about the authorization.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[^<>]+$`),
+						"the input is invalid"),
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The authorization status.`,
+			},
+		},
+	}
+}
+
+func resourceAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createAuthorization: authorize the network instance to the cloud connection of the other account, the API
+	// spells the authorization as 'authorisation'.
+	var (
+		createAuthorizationHttpUrl = "v3/{domain_id}/ccaas/authorisations"
+		createAuthorizationProduct = "cc"
+	)
+	createAuthorizationClient, err := cfg.NewServiceClient(createAuthorizationProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating Authorization Client: %s", err)
+	}
+
+	createAuthorizationPath := createAuthorizationClient.Endpoint + createAuthorizationHttpUrl
+	createAuthorizationPath = strings.ReplaceAll(createAuthorizationPath, "{domain_id}", cfg.DomainID)
+
+	createAuthorizationOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+	}
+	createAuthorizationOpt.JSONBody = utils.RemoveNil(buildCreateAuthorizationBodyParams(d))
+	createAuthorizationResp, err := createAuthorizationClient.Request("POST", createAuthorizationPath,
+		&createAuthorizationOpt)
+	if err != nil {
+		return diag.Errorf("error creating Authorization: %s", err)
+	}
+
+	createAuthorizationRespBody, err := utils.FlattenResponse(createAuthorizationResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := jmespath.Search("authorisation.id", createAuthorizationRespBody)
+	if err != nil || id == nil {
+		return diag.Errorf("error creating Authorization: ID is not found in API response")
+	}
+	d.SetId(id.(string))
+
+	return resourceAuthorizationRead(ctx, d, meta)
+}
+
+func buildCreateAuthorizationBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"authorisation": map[string]interface{}{
+			"name":                       utils.ValueIngoreEmpty(d.Get("name")),
+			"description":                utils.ValueIngoreEmpty(d.Get("description")),
+			"instance_type":              utils.ValueIngoreEmpty(d.Get("instance_type")),
+			"instance_id":                utils.ValueIngoreEmpty(d.Get("instance_id")),
+			"project_id":                 utils.ValueIngoreEmpty(d.Get("project_id")),
+			"region_id":                  utils.ValueIngoreEmpty(d.Get("region_id")),
+			"cloud_connection_domain_id": utils.ValueIngoreEmpty(d.Get("cloud_connection_domain_id")),
+			"cloud_connection_id":        utils.ValueIngoreEmpty(d.Get("cloud_connection_id")),
+		},
+	}
+	return bodyParams
+}
+
+func resourceAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getAuthorization: Query the authorization, there is no API to query the authorization by its ID.
+	var (
+		getAuthorizationHttpUrl = "v3/{domain_id}/ccaas/authorisations?id={id}"
+		getAuthorizationProduct = "cc"
+	)
+	getAuthorizationClient, err := cfg.NewServiceClient(getAuthorizationProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating Authorization Client: %s", err)
+	}
+
+	getAuthorizationPath := getAuthorizationClient.Endpoint + getAuthorizationHttpUrl
+	getAuthorizationPath = strings.ReplaceAll(getAuthorizationPath, "{domain_id}", cfg.DomainID)
+	getAuthorizationPath = strings.ReplaceAll(getAuthorizationPath, "{id}", d.Id())
+
+	getAuthorizationOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getAuthorizationResp, err := getAuthorizationClient.Request("GET", getAuthorizationPath, &getAuthorizationOpt)
+
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Authorization")
+	}
+
+	getAuthorizationRespBody, err := utils.FlattenResponse(getAuthorizationResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	authorization := utils.PathSearch(fmt.Sprintf("authorisations[?id=='%s']|[0]", d.Id()),
+		getAuthorizationRespBody, nil)
+	if authorization == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving Authorization")
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", authorization, nil)),
+		d.Set("description", utils.PathSearch("description", authorization, nil)),
+		d.Set("instance_type", utils.PathSearch("instance_type", authorization, nil)),
+		d.Set("instance_id", utils.PathSearch("instance_id", authorization, nil)),
+		d.Set("project_id", utils.PathSearch("project_id", authorization, nil)),
+		d.Set("region_id", utils.PathSearch("region_id", authorization, nil)),
+		d.Set("cloud_connection_domain_id", utils.PathSearch("cloud_connection_domain_id", authorization, nil)),
+		d.Set("cloud_connection_id", utils.PathSearch("cloud_connection_id", authorization, nil)),
+		d.Set("status", utils.PathSearch("status", authorization, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceAuthorizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	updateAuthorizationhasChanges := []string{
+		"name",
+		"description",
+	}
+
+	if d.HasChanges(updateAuthorizationhasChanges...) {
+		// updateAuthorization: update the authorization
+		var (
+			updateAuthorizationHttpUrl = "v3/{domain_id}/ccaas/authorisations/{id}"
+			updateAuthorizationProduct = "cc"
+		)
+		updateAuthorizationClient, err := cfg.NewServiceClient(updateAuthorizationProduct, region)
+		if err != nil {
+			return diag.Errorf("error creating Authorization Client: %s", err)
+		}
+
+		updateAuthorizationPath := updateAuthorizationClient.Endpoint + updateAuthorizationHttpUrl
+		updateAuthorizationPath = strings.ReplaceAll(updateAuthorizationPath, "{domain_id}", cfg.DomainID)
+		updateAuthorizationPath = strings.ReplaceAll(updateAuthorizationPath, "{id}", d.Id())
+
+		updateAuthorizationOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			JSONBody: map[string]interface{}{
+				"authorisation": utils.RemoveNil(map[string]interface{}{
+					"name":        utils.ValueIngoreEmpty(d.Get("name")),
+					"description": d.Get("description"),
+				}),
+			},
+		}
+		_, err = updateAuthorizationClient.Request("PUT", updateAuthorizationPath, &updateAuthorizationOpt)
+		if err != nil {
+			return diag.Errorf("error updating Authorization: %s", err)
+		}
+	}
+	return resourceAuthorizationRead(ctx, d, meta)
+}
+
+func resourceAuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteAuthorization: delete the authorization
+	var (
+		deleteAuthorizationHttpUrl = "v3/{domain_id}/ccaas/authorisations/{id}"
+		deleteAuthorizationProduct = "cc"
+	)
+	deleteAuthorizationClient, err := cfg.NewServiceClient(deleteAuthorizationProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating Authorization Client: %s", err)
+	}
+
+	deleteAuthorizationPath := deleteAuthorizationClient.Endpoint + deleteAuthorizationHttpUrl
+	deleteAuthorizationPath = strings.ReplaceAll(deleteAuthorizationPath, "{domain_id}", cfg.DomainID)
+	deleteAuthorizationPath = strings.ReplaceAll(deleteAuthorizationPath, "{id}", d.Id())
+
+	deleteAuthorizationOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = deleteAuthorizationClient.Request("DELETE", deleteAuthorizationPath, &deleteAuthorizationOpt)
+	if err != nil {
+		return diag.Errorf("error deleting Authorization: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,422 @@
+package cc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/jmespath/go-jmespath"
+)
+
+func ResourceBandwidthPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBandwidthPackageCreate,
+		UpdateContext: resourceBandwidthPackageUpdate,
+		ReadContext:   resourceBandwidthPackageRead,
+		DeleteContext: resourceBandwidthPackageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The bandwidth package name.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[\x{4E00}-\x{9FFC}A-Za-z-_0-9.]*$`),
+						"the input is invalid"),
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"local_area_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The local area ID.`,
+			},
+			"remote_area_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The remote area ID.`,
+			},
+			"charge_mode": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Billing option of the bandwidth package.`,
+			},
+			"billing_mode": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Billing mode of the bandwidth package.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"3", "4", "5", "6",
+				}, false),
+			},
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: `Bandwidth in the bandwidth package, in Mbit/s.`,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Project ID.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description about the bandwidth package.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[^<>]+$`),
+						"the input is invalid"),
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `ID of the enterprise project that the bandwidth package belongs to.`,
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  `ID of the resource that the bandwidth package is bound to.`,
+				RequiredWith: []string{"resource_type"},
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Type of the resource that the bandwidth package is bound to.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"cloud_connection",
+				}, false),
+			},
+			"interflow_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Interflow mode of the bandwidth package.`,
+			},
+			"spec_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specification code of the bandwidth package.`,
+			},
+			"tags": common.TagsForceNewSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the bandwidth package.`,
+			},
+		},
+	}
+}
+
+func resourceBandwidthPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createBandwidthPackage: create a bandwidth package.
+	var (
+		createBandwidthPackageHttpUrl = "v3/{domain_id}/ccaas/bandwidth-packages"
+		createBandwidthPackageProduct = "cc"
+	)
+	createBandwidthPackageClient, err := cfg.NewServiceClient(createBandwidthPackageProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating BandwidthPackage Client: %s", err)
+	}
+
+	createBandwidthPackagePath := createBandwidthPackageClient.Endpoint + createBandwidthPackageHttpUrl
+	createBandwidthPackagePath = strings.ReplaceAll(createBandwidthPackagePath, "{domain_id}", cfg.DomainID)
+
+	createBandwidthPackageOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+	}
+	createBandwidthPackageOpt.JSONBody = utils.RemoveNil(buildCreateBandwidthPackageBodyParams(d, cfg))
+	createBandwidthPackageResp, err := createBandwidthPackageClient.Request("POST", createBandwidthPackagePath,
+		&createBandwidthPackageOpt)
+	if err != nil {
+		return diag.Errorf("error creating BandwidthPackage: %s", err)
+	}
+
+	createBandwidthPackageRespBody, err := utils.FlattenResponse(createBandwidthPackageResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := jmespath.Search("bandwidth_package.id", createBandwidthPackageRespBody)
+	if err != nil || id == nil {
+		return diag.Errorf("error creating BandwidthPackage: ID is not found in API response")
+	}
+	d.SetId(id.(string))
+
+	return resourceBandwidthPackageRead(ctx, d, meta)
+}
+
+func buildCreateBandwidthPackageBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"bandwidth_package": buildCreateBandwidthPackageBandwidthPackageChildBody(d, cfg),
+	}
+	return bodyParams
+}
+
+func buildCreateBandwidthPackageBandwidthPackageChildBody(d *schema.ResourceData,
+	cfg *config.Config) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":                  utils.ValueIngoreEmpty(d.Get("name")),
+		"description":           utils.ValueIngoreEmpty(d.Get("description")),
+		"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+		"local_area_id":         utils.ValueIngoreEmpty(d.Get("local_area_id")),
+		"remote_area_id":        utils.ValueIngoreEmpty(d.Get("remote_area_id")),
+		"charge_mode":           utils.ValueIngoreEmpty(d.Get("charge_mode")),
+		"billing_mode":          utils.ValueIngoreEmpty(d.Get("billing_mode")),
+		"bandwidth":             utils.ValueIngoreEmpty(d.Get("bandwidth")),
+		"project_id":            utils.ValueIngoreEmpty(d.Get("project_id")),
+		"resource_id":           utils.ValueIngoreEmpty(d.Get("resource_id")),
+		"resource_type":         utils.ValueIngoreEmpty(d.Get("resource_type")),
+		"interflow_mode":        utils.ValueIngoreEmpty(d.Get("interflow_mode")),
+		"spec_code":             utils.ValueIngoreEmpty(d.Get("spec_code")),
+		"tags":                  utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+	}
+	return params
+}
+
+func resourceBandwidthPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getBandwidthPackage: Query the bandwidth package
+	var (
+		getBandwidthPackageHttpUrl = "v3/{domain_id}/ccaas/bandwidth-packages/{id}"
+		getBandwidthPackageProduct = "cc"
+	)
+	getBandwidthPackageClient, err := cfg.NewServiceClient(getBandwidthPackageProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating BandwidthPackage Client: %s", err)
+	}
+
+	getBandwidthPackagePath := getBandwidthPackageClient.Endpoint + getBandwidthPackageHttpUrl
+	getBandwidthPackagePath = strings.ReplaceAll(getBandwidthPackagePath, "{domain_id}", cfg.DomainID)
+	getBandwidthPackagePath = strings.ReplaceAll(getBandwidthPackagePath, "{id}", d.Id())
+
+	getBandwidthPackageOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getBandwidthPackageResp, err := getBandwidthPackageClient.Request("GET", getBandwidthPackagePath,
+		&getBandwidthPackageOpt)
+
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving BandwidthPackage")
+	}
+
+	getBandwidthPackageRespBody, err := utils.FlattenResponse(getBandwidthPackageResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the billing mode is returned as a number
+	billingMode := utils.PathSearch("bandwidth_package.billing_mode", getBandwidthPackageRespBody, nil)
+	if billingMode != nil {
+		billingMode = fmt.Sprint(billingMode)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("bandwidth_package.name", getBandwidthPackageRespBody, nil)),
+		d.Set("description", utils.PathSearch("bandwidth_package.description", getBandwidthPackageRespBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("bandwidth_package.enterprise_project_id",
+			getBandwidthPackageRespBody, nil)),
+		d.Set("local_area_id", utils.PathSearch("bandwidth_package.local_area_id", getBandwidthPackageRespBody, nil)),
+		d.Set("remote_area_id", utils.PathSearch("bandwidth_package.remote_area_id", getBandwidthPackageRespBody, nil)),
+		d.Set("charge_mode", utils.PathSearch("bandwidth_package.charge_mode", getBandwidthPackageRespBody, nil)),
+		d.Set("billing_mode", billingMode),
+		d.Set("bandwidth", utils.PathSearch("bandwidth_package.bandwidth", getBandwidthPackageRespBody, nil)),
+		d.Set("project_id", utils.PathSearch("bandwidth_package.project_id", getBandwidthPackageRespBody, nil)),
+		d.Set("resource_id", utils.PathSearch("bandwidth_package.resource_id", getBandwidthPackageRespBody, nil)),
+		d.Set("resource_type", utils.PathSearch("bandwidth_package.resource_type", getBandwidthPackageRespBody, nil)),
+		d.Set("interflow_mode", utils.PathSearch("bandwidth_package.interflow_mode", getBandwidthPackageRespBody, nil)),
+		d.Set("spec_code", utils.PathSearch("bandwidth_package.spec_code", getBandwidthPackageRespBody, nil)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("bandwidth_package.tags", getBandwidthPackageRespBody,
+			nil))),
+		d.Set("status", utils.PathSearch("bandwidth_package.status", getBandwidthPackageRespBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceBandwidthPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	bandwidthPackageClient, err := cfg.NewServiceClient("cc", region)
+	if err != nil {
+		return diag.Errorf("error creating BandwidthPackage Client: %s", err)
+	}
+
+	updateBandwidthPackagehasChanges := []string{
+		"name",
+		"description",
+		"bandwidth",
+	}
+
+	if d.HasChanges(updateBandwidthPackagehasChanges...) {
+		// updateBandwidthPackage: update the bandwidth package
+		updateBandwidthPackageHttpUrl := "v3/{domain_id}/ccaas/bandwidth-packages/{id}"
+		updateBandwidthPackagePath := bandwidthPackageClient.Endpoint + updateBandwidthPackageHttpUrl
+		updateBandwidthPackagePath = strings.ReplaceAll(updateBandwidthPackagePath, "{domain_id}", cfg.DomainID)
+		updateBandwidthPackagePath = strings.ReplaceAll(updateBandwidthPackagePath, "{id}", d.Id())
+
+		updateBandwidthPackageOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+		}
+		updateBandwidthPackageOpt.JSONBody = utils.RemoveNil(buildUpdateBandwidthPackageBodyParams(d))
+		_, err = bandwidthPackageClient.Request("PUT", updateBandwidthPackagePath, &updateBandwidthPackageOpt)
+		if err != nil {
+			return diag.Errorf("error updating BandwidthPackage: %s", err)
+		}
+	}
+
+	if d.HasChanges("resource_id", "resource_type") {
+		// the bandwidth package must be unbound from the old resource before it is bound to the new one
+		oldId, _ := d.GetChange("resource_id")
+		oldType, _ := d.GetChange("resource_type")
+		newId := d.Get("resource_id").(string)
+		if oldId.(string) != "" {
+			err = associateBandwidthPackage(bandwidthPackageClient, cfg.DomainID, d.Id(), "disassociate",
+				oldId.(string), oldType.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if newId != "" {
+			err = associateBandwidthPackage(bandwidthPackageClient, cfg.DomainID, d.Id(), "associate",
+				newId, d.Get("resource_type").(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	return resourceBandwidthPackageRead(ctx, d, meta)
+}
+
+func buildUpdateBandwidthPackageBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"bandwidth_package": map[string]interface{}{
+			"name":        utils.ValueIngoreEmpty(d.Get("name")),
+			"description": d.Get("description"),
+			"bandwidth":   utils.ValueIngoreEmpty(d.Get("bandwidth")),
+		},
+	}
+	return bodyParams
+}
+
+// associateBandwidthPackage binds the bandwidth package to the resource or unbinds it from the resource, the action
+// is 'associate' or 'disassociate'.
+func associateBandwidthPackage(client *golangsdk.ServiceClient, domainId, packageId, action, resourceId,
+	resourceType string) error {
+	associateBandwidthPackageHttpUrl := "v3/{domain_id}/ccaas/bandwidth-packages/{id}/" + action
+	associateBandwidthPackagePath := client.Endpoint + associateBandwidthPackageHttpUrl
+	associateBandwidthPackagePath = strings.ReplaceAll(associateBandwidthPackagePath, "{domain_id}", domainId)
+	associateBandwidthPackagePath = strings.ReplaceAll(associateBandwidthPackagePath, "{id}", packageId)
+
+	associateBandwidthPackageOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"bandwidth_package": map[string]interface{}{
+				"resource_id":   resourceId,
+				"resource_type": resourceType,
+			},
+		},
+	}
+
+	// the binding changes the bandwidth package number of the cloud connection
+	config.MutexKV.Lock(resourceId)
+	defer config.MutexKV.Unlock(resourceId)
+
+	_, err := client.Request("POST", associateBandwidthPackagePath, &associateBandwidthPackageOpt)
+	if err != nil {
+		return fmt.Errorf("error executing BandwidthPackage %s action (resource ID: %s): %s", action,
+			resourceId, err)
+	}
+	return nil
+}
+
+func resourceBandwidthPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	bandwidthPackageClient, err := cfg.NewServiceClient("cc", region)
+	if err != nil {
+		return diag.Errorf("error creating BandwidthPackage Client: %s", err)
+	}
+
+	// a bound bandwidth package can not be deleted
+	if resourceId := d.Get("resource_id").(string); resourceId != "" {
+		err = associateBandwidthPackage(bandwidthPackageClient, cfg.DomainID, d.Id(), "disassociate",
+			resourceId, d.Get("resource_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// deleteBandwidthPackage: delete the bandwidth package
+	deleteBandwidthPackageHttpUrl := "v3/{domain_id}/ccaas/bandwidth-packages/{id}"
+	deleteBandwidthPackagePath := bandwidthPackageClient.Endpoint + deleteBandwidthPackageHttpUrl
+	deleteBandwidthPackagePath = strings.ReplaceAll(deleteBandwidthPackagePath, "{domain_id}", cfg.DomainID)
+	deleteBandwidthPackagePath = strings.ReplaceAll(deleteBandwidthPackagePath, "{id}", d.Id())
+
+	deleteBandwidthPackageOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = bandwidthPackageClient.Request("DELETE", deleteBandwidthPackagePath, &deleteBandwidthPackageOpt)
+	if err != nil {
+		return diag.Errorf("error deleting BandwidthPackage: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,317 @@
+package cc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/jmespath/go-jmespath"
+)
+
+func ResourceInterRegionBandwidth() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInterRegionBandwidthCreate,
+		UpdateContext: resourceInterRegionBandwidthUpdate,
+		ReadContext:   resourceInterRegionBandwidthRead,
+		DeleteContext: resourceInterRegionBandwidthDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cloud_connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Cloud connection ID.`,
+			},
+			"bandwidth_package_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Bandwidth package ID.`,
+			},
+			"inter_region_ids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    2,
+				MaxItems:    2,
+				Description: `Two regions to which bandwidth is allocated.`,
+			},
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: `Inter-region bandwidth, in Mbit/s.`,
+			},
+			"inter_regions": {
+				Type:        schema.TypeList,
+				Elem:        interRegionBandwidthInterRegionSchema(),
+				Computed:    true,
+				Description: `Details of the regions.`,
+			},
+		},
+	}
+}
+
+func interRegionBandwidthInterRegionSchema() *schema.Resource {
+	sc := schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Inter-region bandwidth ID.`,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Project ID of a region where the inter-region bandwidth is used.`,
+			},
+			"local_region_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `ID of the local region where the inter-region bandwidth is used.`,
+			},
+			"remote_region_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `ID of the remote region where the inter-region bandwidth is used.`,
+			},
+		},
+	}
+	return &sc
+}
+
+func resourceInterRegionBandwidthCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createInterRegionBandwidth: create an inter-region bandwidth.
+	var (
+		createInterRegionBandwidthHttpUrl = "v3/{domain_id}/ccaas/inter-region-bandwidths"
+		createInterRegionBandwidthProduct = "cc"
+	)
+	createInterRegionBandwidthClient, err := cfg.NewServiceClient(createInterRegionBandwidthProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating InterRegionBandwidth Client: %s", err)
+	}
+
+	createInterRegionBandwidthPath := createInterRegionBandwidthClient.Endpoint + createInterRegionBandwidthHttpUrl
+	createInterRegionBandwidthPath = strings.ReplaceAll(createInterRegionBandwidthPath, "{domain_id}", cfg.DomainID)
+
+	createInterRegionBandwidthOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+	}
+	createInterRegionBandwidthOpt.JSONBody = utils.RemoveNil(buildCreateInterRegionBandwidthBodyParams(d))
+
+	// the inter-region bandwidths of the same cloud connection can not be created at the same time
+	cloudConnectionId := d.Get("cloud_connection_id").(string)
+	config.MutexKV.Lock(cloudConnectionId)
+	defer config.MutexKV.Unlock(cloudConnectionId)
+
+	createInterRegionBandwidthResp, err := createInterRegionBandwidthClient.Request("POST",
+		createInterRegionBandwidthPath, &createInterRegionBandwidthOpt)
+	if err != nil {
+		return diag.Errorf("error creating InterRegionBandwidth: %s", err)
+	}
+
+	createInterRegionBandwidthRespBody, err := utils.FlattenResponse(createInterRegionBandwidthResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := jmespath.Search("inter_region_bandwidth.id", createInterRegionBandwidthRespBody)
+	if err != nil || id == nil {
+		return diag.Errorf("error creating InterRegionBandwidth: ID is not found in API response")
+	}
+	d.SetId(id.(string))
+
+	return resourceInterRegionBandwidthRead(ctx, d, meta)
+}
+
+func buildCreateInterRegionBandwidthBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"inter_region_bandwidth": map[string]interface{}{
+			"cloud_connection_id":  utils.ValueIngoreEmpty(d.Get("cloud_connection_id")),
+			"bandwidth_package_id": utils.ValueIngoreEmpty(d.Get("bandwidth_package_id")),
+			"bandwidth":            utils.ValueIngoreEmpty(d.Get("bandwidth")),
+			"inter_region_ids":     utils.ValueIngoreEmpty(d.Get("inter_region_ids")),
+		},
+	}
+	return bodyParams
+}
+
+func resourceInterRegionBandwidthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getInterRegionBandwidth: Query the inter-region bandwidth
+	var (
+		getInterRegionBandwidthHttpUrl = "v3/{domain_id}/ccaas/inter-region-bandwidths/{id}"
+		getInterRegionBandwidthProduct = "cc"
+	)
+	getInterRegionBandwidthClient, err := cfg.NewServiceClient(getInterRegionBandwidthProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating InterRegionBandwidth Client: %s", err)
+	}
+
+	getInterRegionBandwidthPath := getInterRegionBandwidthClient.Endpoint + getInterRegionBandwidthHttpUrl
+	getInterRegionBandwidthPath = strings.ReplaceAll(getInterRegionBandwidthPath, "{domain_id}", cfg.DomainID)
+	getInterRegionBandwidthPath = strings.ReplaceAll(getInterRegionBandwidthPath, "{id}", d.Id())
+
+	getInterRegionBandwidthOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getInterRegionBandwidthResp, err := getInterRegionBandwidthClient.Request("GET", getInterRegionBandwidthPath,
+		&getInterRegionBandwidthOpt)
+
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving InterRegionBandwidth")
+	}
+
+	getInterRegionBandwidthRespBody, err := utils.FlattenResponse(getInterRegionBandwidthResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("cloud_connection_id", utils.PathSearch("inter_region_bandwidth.cloud_connection_id",
+			getInterRegionBandwidthRespBody, nil)),
+		d.Set("bandwidth_package_id", utils.PathSearch("inter_region_bandwidth.bandwidth_package_id",
+			getInterRegionBandwidthRespBody, nil)),
+		d.Set("bandwidth", utils.PathSearch("inter_region_bandwidth.bandwidth", getInterRegionBandwidthRespBody, nil)),
+		d.Set("inter_regions", flattenGetInterRegionBandwidthResponseBodyInterRegion(getInterRegionBandwidthRespBody)),
+	)
+
+	// the region IDs are only set when importing, the order of the inter-regions is not guaranteed
+	if _, ok := d.GetOk("inter_region_ids"); !ok {
+		mErr = multierror.Append(mErr, d.Set("inter_region_ids", []interface{}{
+			utils.PathSearch("inter_region_bandwidth.inter_regions[0].local_region_id",
+				getInterRegionBandwidthRespBody, nil),
+			utils.PathSearch("inter_region_bandwidth.inter_regions[0].remote_region_id",
+				getInterRegionBandwidthRespBody, nil),
+		}))
+	}
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenGetInterRegionBandwidthResponseBodyInterRegion(resp interface{}) []interface{} {
+	curJson := utils.PathSearch("inter_region_bandwidth.inter_regions", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"id":               utils.PathSearch("id", v, nil),
+			"project_id":       utils.PathSearch("project_id", v, nil),
+			"local_region_id":  utils.PathSearch("local_region_id", v, nil),
+			"remote_region_id": utils.PathSearch("remote_region_id", v, nil),
+		})
+	}
+	return rst
+}
+
+func resourceInterRegionBandwidthUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	if d.HasChange("bandwidth") {
+		// updateInterRegionBandwidth: update the inter-region bandwidth
+		var (
+			updateInterRegionBandwidthHttpUrl = "v3/{domain_id}/ccaas/inter-region-bandwidths/{id}"
+			updateInterRegionBandwidthProduct = "cc"
+		)
+		updateInterRegionBandwidthClient, err := cfg.NewServiceClient(updateInterRegionBandwidthProduct, region)
+		if err != nil {
+			return diag.Errorf("error creating InterRegionBandwidth Client: %s", err)
+		}
+
+		updateInterRegionBandwidthPath := updateInterRegionBandwidthClient.Endpoint + updateInterRegionBandwidthHttpUrl
+		updateInterRegionBandwidthPath = strings.ReplaceAll(updateInterRegionBandwidthPath, "{domain_id}",
+			cfg.DomainID)
+		updateInterRegionBandwidthPath = strings.ReplaceAll(updateInterRegionBandwidthPath, "{id}", d.Id())
+
+		updateInterRegionBandwidthOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			JSONBody: map[string]interface{}{
+				"inter_region_bandwidth": map[string]interface{}{
+					"bandwidth": d.Get("bandwidth"),
+				},
+			},
+		}
+		_, err = updateInterRegionBandwidthClient.Request("PUT", updateInterRegionBandwidthPath,
+			&updateInterRegionBandwidthOpt)
+		if err != nil {
+			return diag.Errorf("error updating InterRegionBandwidth: %s", err)
+		}
+	}
+	return resourceInterRegionBandwidthRead(ctx, d, meta)
+}
+
+func resourceInterRegionBandwidthDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteInterRegionBandwidth: delete the inter-region bandwidth
+	var (
+		deleteInterRegionBandwidthHttpUrl = "v3/{domain_id}/ccaas/inter-region-bandwidths/{id}"
+		deleteInterRegionBandwidthProduct = "cc"
+	)
+	deleteInterRegionBandwidthClient, err := cfg.NewServiceClient(deleteInterRegionBandwidthProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating InterRegionBandwidth Client: %s", err)
+	}
+
+	deleteInterRegionBandwidthPath := deleteInterRegionBandwidthClient.Endpoint + deleteInterRegionBandwidthHttpUrl
+	deleteInterRegionBandwidthPath = strings.ReplaceAll(deleteInterRegionBandwidthPath, "{domain_id}", cfg.DomainID)
+	deleteInterRegionBandwidthPath = strings.ReplaceAll(deleteInterRegionBandwidthPath, "{id}", d.Id())
+
+	deleteInterRegionBandwidthOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+
+	cloudConnectionId := d.Get("cloud_connection_id").(string)
+	config.MutexKV.Lock(cloudConnectionId)
+	defer config.MutexKV.Unlock(cloudConnectionId)
+
+	_, err = deleteInterRegionBandwidthClient.Request("DELETE", deleteInterRegionBandwidthPath,
+		&deleteInterRegionBandwidthOpt)
+	if err != nil {
+		return diag.Errorf("error deleting InterRegionBandwidth: %s", err)
+	}
+
+	return nil
+}
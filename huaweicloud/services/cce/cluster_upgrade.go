@@ -0,0 +1,290 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/nodes"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The in-place upgrade of the cluster version contains the following steps:
+// 1. run the pre-check task and make sure all the check items of the cluster, nodes and add-ons are passed;
+// 2. run the upgrade task, the master nodes are upgraded first and then the worker nodes are upgraded in place;
+// 3. wait for the cluster to become available.
+
+// checkItemPaths are the paths of the check stages in the pre-check task, and the paths of the names of the
+// objects (node or add-on) to which the check items belong.
+var checkItemPaths = []struct {
+	object string
+	stages string
+	name   string
+}{
+	{object: "cluster", stages: "status.clusterCheckResult"},
+	{object: "node", stages: "status.nodeCheckResult.nodeStageStatus", name: "nodeInfo.name"},
+	{object: "add-on", stages: "status.addonCheckResult.addonStageStatus", name: "addonInfo.addonTemplateName"},
+}
+
+func buildClusterUpgradeURL(client *golangsdk.ServiceClient, clusterID, path string) string {
+	requestPath := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/operation/" + path
+	requestPath = strings.ReplaceAll(requestPath, "{project_id}", client.ProjectID)
+	requestPath = strings.ReplaceAll(requestPath, "{cluster_id}", clusterID)
+	return requestPath
+}
+
+func doClusterOperation(client *golangsdk.ServiceClient, method, requestPath string,
+	body map[string]interface{}) (interface{}, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+	}
+	if body != nil {
+		opt.JSONBody = utils.RemoveNil(body)
+	}
+
+	resp, err := client.Request(method, requestPath, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func buildClusterPreCheckBodyParams(d *schema.ResourceData) map[string]interface{} {
+	oldVersion, newVersion := d.GetChange("cluster_version")
+	return map[string]interface{}{
+		"apiVersion": "v3",
+		"kind":       "PreCheckTask",
+		"spec": map[string]interface{}{
+			"clusterVersion": oldVersion,
+			"targetVersion":  newVersion,
+		},
+	}
+}
+
+func buildClusterUpgradeBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"apiVersion": "v3",
+			"kind":       "UpgradeTask",
+		},
+		"spec": map[string]interface{}{
+			"clusterUpgradeAction": map[string]interface{}{
+				"targetVersion": d.Get("cluster_version"),
+				"strategy": map[string]interface{}{
+					"type": "inPlaceRollingUpdate",
+				},
+			},
+		},
+	}
+}
+
+func clusterTaskRefreshFunc(client *golangsdk.ServiceClient, requestPath string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := doClusterOperation(client, "GET", requestPath, nil)
+		if err != nil {
+			return nil, "", err
+		}
+
+		phase := utils.PathSearch("status.phase", respBody, "").(string)
+		return respBody, phase, nil
+	}
+}
+
+// flattenFailedCheckItems converts the failed check items of the pre-check task to the diagnostics.
+func flattenFailedCheckItems(clusterID string, taskBody interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, p := range checkItemPaths {
+		stages := utils.PathSearch(p.stages, taskBody, nil)
+		stageList, ok := stages.([]interface{})
+		if !ok {
+			stageList = []interface{}{stages}
+		}
+
+		for _, stage := range stageList {
+			items := utils.PathSearch("itemsStatus[?status=='Failed']", stage, make([]interface{}, 0)).([]interface{})
+			for _, item := range items {
+				object := fmt.Sprintf("%s %s", p.object, clusterID)
+				if p.name != "" {
+					object = fmt.Sprintf("%s %v", p.object, utils.PathSearch(p.name, stage, ""))
+				}
+
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary: fmt.Sprintf("CCE upgrade pre-check item %v failed",
+						utils.PathSearch("name", item, "")),
+					Detail: fmt.Sprintf("the check item %v of the %s failed (type: %v, error codes: %v): %v",
+						utils.PathSearch("name", item, ""), object, utils.PathSearch("itemType", item, ""),
+						utils.PathSearch("errorCodes", item, nil), utils.PathSearch("message", item, "")),
+				})
+			}
+		}
+	}
+	return diags
+}
+
+// resourceClusterPreCheck runs the upgrade pre-check task and returns the failed check items as the diagnostics.
+func resourceClusterPreCheck(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) diag.Diagnostics {
+	clusterID := d.Id()
+	respBody, err := doClusterOperation(client, "POST", buildClusterUpgradeURL(client, clusterID, "precheck"),
+		buildClusterPreCheckBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error creating the upgrade pre-check task of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	taskID := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if taskID == "" {
+		return diag.Errorf("unable to find the pre-check task ID of CCE cluster (%s) from the API response", clusterID)
+	}
+
+	log.Printf("[DEBUG] Waiting for the upgrade pre-check task (%s) of CCE cluster (%s) to complete", taskID, clusterID)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Init", "Running"},
+		Target:       []string{"Success", "Failed"},
+		Refresh:      clusterTaskRefreshFunc(client, buildClusterUpgradeURL(client, clusterID, "precheck/tasks/"+taskID)),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	taskBody, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the upgrade pre-check task (%s) of CCE cluster (%s) to complete: %s",
+			taskID, clusterID, err)
+	}
+
+	if utils.PathSearch("status.phase", taskBody, "") == "Success" {
+		return nil
+	}
+
+	diags := flattenFailedCheckItems(clusterID, taskBody)
+	if len(diags) == 0 {
+		diags = diag.Errorf("the upgrade pre-check task (%s) of CCE cluster (%s) failed: %v", taskID, clusterID,
+			utils.PathSearch("status.message", taskBody, ""))
+	}
+	return diags
+}
+
+// resourceClusterUpgrade runs the upgrade task and waits for the cluster to become available,
+// the ID of the upgrade task is returned even if the upgrade fails.
+func resourceClusterUpgrade(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) (string, error) {
+	clusterID := d.Id()
+	respBody, err := doClusterOperation(client, "POST", buildClusterUpgradeURL(client, clusterID, "upgrade"),
+		buildClusterUpgradeBodyParams(d))
+	if err != nil {
+		return "", fmt.Errorf("error upgrading CCE cluster (%s): %s", clusterID, err)
+	}
+
+	taskID := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if taskID == "" {
+		return "", fmt.Errorf("unable to find the upgrade task ID of CCE cluster (%s) from the API response", clusterID)
+	}
+
+	log.Printf("[DEBUG] Waiting for the upgrade task (%s) of CCE cluster (%s) to complete", taskID, clusterID)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Init", "Queuing", "Running"},
+		Target:       []string{"Success"},
+		Refresh:      clusterTaskRefreshFunc(client, buildClusterUpgradeURL(client, clusterID, "upgrade/tasks/"+taskID)),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        30 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	taskBody, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		// the failed upgrade may be rolled back by CCE, report the phase of the task and the cluster
+		clusterPhase := "unknown"
+		if _, phase, cErr := waitForClusterActive(client, clusterID)(); cErr == nil {
+			clusterPhase = phase
+		}
+		return taskID, fmt.Errorf("error waiting for the upgrade task (%s) of CCE cluster (%s) to complete: %s, "+
+			"task phase: %v, message: %v, cluster status: %s; please check the task in the CCE console, the cluster "+
+			"may need to be rolled back or the upgrade to be retried", taskID, clusterID, err,
+			utils.PathSearch("status.phase", taskBody, ""), utils.PathSearch("status.message", taskBody, ""),
+			clusterPhase)
+	}
+
+	if err = waitForClusterOperationComplete(ctx, d, client); err != nil {
+		return taskID, err
+	}
+	return taskID, nil
+}
+
+func buildClusterResizeBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"flavorResize": d.Get("flavor_id"),
+	}
+	if d.Get("charging_mode").(string) == "prePaid" || d.Get("billing_mode").(int) == 1 {
+		bodyParams["extendParam"] = map[string]interface{}{
+			"isAutoPay": common.GetAutoPay(d),
+		}
+	}
+	return bodyParams
+}
+
+// resourceClusterResize scales up the flavor of the cluster, only the scale-up is supported by CCE.
+func resourceClusterResize(ctx context.Context, d *schema.ResourceData, cfg *config.Config,
+	client *golangsdk.ServiceClient) error {
+	clusterID := d.Id()
+	respBody, err := doClusterOperation(client, "POST", buildClusterUpgradeURL(client, clusterID, "resize"),
+		buildClusterResizeBodyParams(d))
+	if err != nil {
+		return fmt.Errorf("error resizing the flavor of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	if orderID := utils.PathSearch("orderID", respBody, "").(string); orderID != "" {
+		bssClient, err := cfg.BssV2Client(cfg.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating BSS v2 client: %s", err)
+		}
+		if err = common.WaitOrderComplete(ctx, bssClient, orderID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if jobID := utils.PathSearch("jobID", respBody, "").(string); jobID != "" {
+		stateJob := &resource.StateChangeConf{
+			Pending:      []string{"Initializing", "Running"},
+			Target:       []string{"Success"},
+			Refresh:      waitForJobStatus(client, jobID),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        30 * time.Second,
+			PollInterval: 20 * time.Second,
+		}
+		v, err := stateJob.WaitForStateContext(ctx)
+		if err != nil {
+			if job, ok := v.(*nodes.Job); ok {
+				return fmt.Errorf("error waiting for the resize job (%s) of CCE cluster (%s) to become success: "+
+					"%s, reason: %s", jobID, clusterID, err, job.Status.Reason)
+			}
+			return fmt.Errorf("error waiting for the resize job (%s) of CCE cluster (%s) to become success: %s",
+				jobID, clusterID, err)
+		}
+	}
+
+	return waitForClusterOperationComplete(ctx, d, client)
+}
+
+func waitForClusterOperationComplete(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	log.Printf("[DEBUG] Waiting for CCE cluster (%s) to become available", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Upgrading", "Resizing", "Unavailable"},
+		Target:       []string{"Available"},
+		Refresh:      waitForClusterActive(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for CCE cluster (%s) to become available: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package cce
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/clusters"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The context names in the kubeconfig returned by the cluster certificate API.
+// The external contexts exist only when the cluster is bound with an EIP, and the context "externalTLSVerify"
+// verifies the server certificate with the cluster CA, while the context "external" skips the verification.
+const (
+	kubeConfigContextInternal          = "internal"
+	kubeConfigContextExternal          = "external"
+	kubeConfigContextExternalTLSVerify = "externalTLSVerify"
+)
+
+func DataSourceCCEClusterKubeConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCCEClusterKubeConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntBetween(1, 1825),
+				),
+			},
+			"endpoint_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "internal",
+				ValidateFunc: validation.StringInSlice([]string{
+					"internal", "external",
+				}, false),
+			},
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"current_context": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"contexts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getClusterKubeConfig(client *golangsdk.ServiceClient, clusterID string, duration int) ([]byte, error) {
+	requestPath := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/clustercert"
+	requestPath = strings.ReplaceAll(requestPath, "{project_id}", client.ProjectID)
+	requestPath = strings.ReplaceAll(requestPath, "{cluster_id}", clusterID)
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"duration": duration,
+		},
+	}
+	resp, err := client.Request("POST", requestPath, &opt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(respBody)
+}
+
+// selectKubeConfigContext returns the context matching the endpoint type, and the verified external context is
+// preferred so that the CA certificate can be used by the clients.
+func selectKubeConfigContext(cert *clusters.Certificate, endpointType string) (*clusters.CertContexts, bool) {
+	candidates := []string{kubeConfigContextInternal}
+	if endpointType == "external" {
+		candidates = []string{kubeConfigContextExternalTLSVerify, kubeConfigContextExternal}
+	}
+
+	for _, name := range candidates {
+		for i, ctx := range cert.Contexts {
+			if ctx.Name == name {
+				return &cert.Contexts[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+func decodeKubeConfigData(data string) (string, error) {
+	if data == "" {
+		return "", nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+func flattenKubeConfigContexts(cert *clusters.Certificate) []map[string]interface{} {
+	servers := make(map[string]string, len(cert.Clusters))
+	for _, c := range cert.Clusters {
+		servers[c.Name] = c.Cluster.Server
+	}
+
+	result := make([]map[string]interface{}, len(cert.Contexts))
+	for i, ctx := range cert.Contexts {
+		result[i] = map[string]interface{}{
+			"name":    ctx.Name,
+			"cluster": ctx.Context.Cluster,
+			"user":    ctx.Context.User,
+			"server":  servers[ctx.Context.Cluster],
+		}
+	}
+	return result
+}
+
+func dataSourceCCEClusterKubeConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	raw, err := getClusterKubeConfig(client, clusterID, d.Get("duration").(int))
+	if err != nil {
+		return diag.Errorf("error retrieving the kubeconfig of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	var cert clusters.Certificate
+	if err = json.Unmarshal(raw, &cert); err != nil {
+		return diag.Errorf("error parsing the kubeconfig of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	endpointType := d.Get("endpoint_type").(string)
+	selected, ok := selectKubeConfigContext(&cert, endpointType)
+	if !ok {
+		return diag.Errorf("unable to find the %s context in the kubeconfig of CCE cluster (%s), "+
+			"please check whether the cluster is bound with an EIP", endpointType, clusterID)
+	}
+
+	// make the raw kubeconfig use the selected context by default
+	var kubeConfig map[string]interface{}
+	if err = json.Unmarshal(raw, &kubeConfig); err != nil {
+		return diag.Errorf("error parsing the kubeconfig of CCE cluster (%s): %s", clusterID, err)
+	}
+	kubeConfig["current-context"] = selected.Name
+	kubeConfigRaw, err := json.Marshal(kubeConfig)
+	if err != nil {
+		return diag.Errorf("error building the kubeconfig of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	var host, caCert, clientCert, clientKey string
+	mErr := &multierror.Error{}
+	for _, c := range cert.Clusters {
+		if c.Name == selected.Context.Cluster {
+			host = c.Cluster.Server
+			caCert, err = decodeKubeConfigData(c.Cluster.CertAuthorityData)
+			mErr = multierror.Append(mErr, err)
+		}
+	}
+	for _, u := range cert.Users {
+		if u.Name == selected.Context.User {
+			clientCert, err = decodeKubeConfigData(u.User.ClientCertData)
+			mErr = multierror.Append(mErr, err)
+			clientKey, err = decodeKubeConfigData(u.User.ClientKeyData)
+			mErr = multierror.Append(mErr, err)
+		}
+	}
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error decoding the certificates of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+	mErr = multierror.Append(mErr,
+		d.Set("region", region),
+		d.Set("kube_config_raw", string(kubeConfigRaw)),
+		d.Set("current_context", selected.Name),
+		d.Set("host", host),
+		d.Set("cluster_ca_certificate", caCert),
+		d.Set("client_certificate", clientCert),
+		d.Set("client_key", clientKey),
+		d.Set("contexts", flattenKubeConfigContexts(&cert)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE cluster kubeconfig fields: %s", err)
+	}
+	return nil
+}
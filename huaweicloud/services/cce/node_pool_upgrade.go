@@ -0,0 +1,303 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/nodepools"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/nodes"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The node pool with upgrade_strategy replaces the nodes in batches when the node template is changed:
+// 1. scale out the node pool by max_surge nodes, which are created with the new node template;
+// 2. cordon and drain a batch of old nodes (max_unavailable + max_surge nodes);
+// 3. delete the batch of old nodes and scale the node pool back to initial_node_count.
+
+// nodePoolRollingKeys are the node template parameters which trigger the rolling replacement of the nodes,
+// the node pool will be recreated if they are changed without upgrade_strategy.
+var nodePoolRollingKeys = []string{
+	"flavor_id", "os", "runtime", "root_volume.0.size", "root_volume.0.volumetype", "root_volume.0.hw_passthrough",
+	"root_volume.0.extend_param", "root_volume.0.extend_params", "root_volume.0.kms_key_id",
+}
+
+const nodePoolIDAnnotation = "kubernetes.io/node-pool.id"
+
+func upgradeStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"drain_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      600,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func resourceNodePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	strategies := d.Get("upgrade_strategy").([]interface{})
+	if len(strategies) > 0 && strategies[0] != nil {
+		strategy := strategies[0].(map[string]interface{})
+		if strategy["max_unavailable"].(int)+strategy["max_surge"].(int) < 1 {
+			return fmt.Errorf("at least one of max_unavailable and max_surge in upgrade_strategy must be " +
+				"greater than 0")
+		}
+		return nil
+	}
+
+	// keep the previous behavior that the node pool is recreated without upgrade_strategy
+	for _, key := range nodePoolRollingKeys {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type nodePoolUpgradeStrategy struct {
+	MaxUnavailable int
+	MaxSurge       int
+	DrainTimeout   time.Duration
+}
+
+func buildNodePoolUpgradeStrategy(d *schema.ResourceData) *nodePoolUpgradeStrategy {
+	strategies := d.Get("upgrade_strategy").([]interface{})
+	if len(strategies) == 0 || strategies[0] == nil {
+		return nil
+	}
+
+	strategy := strategies[0].(map[string]interface{})
+	return &nodePoolUpgradeStrategy{
+		MaxUnavailable: strategy["max_unavailable"].(int),
+		MaxSurge:       strategy["max_surge"].(int),
+		DrainTimeout:   time.Duration(strategy["drain_timeout"].(int)) * time.Second,
+	}
+}
+
+// listNodePoolNodes returns the nodes which belong to the node pool.
+func listNodePoolNodes(client *golangsdk.ServiceClient, clusterID, nodePoolID string) ([]nodes.Nodes, error) {
+	allNodes, err := nodes.List(client, clusterID, nodes.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing the nodes of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	result := make([]nodes.Nodes, 0)
+	for _, n := range allNodes {
+		if n.Metadata.Annotations[nodePoolIDAnnotation] == nodePoolID {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+
+// rollNodePoolNodes replaces the old nodes of the node pool in batches by the nodes with the new node template.
+func rollNodePoolNodes(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	oldNodes []nodes.Nodes) error {
+	strategy := buildNodePoolUpgradeStrategy(d)
+	if strategy == nil || len(oldNodes) == 0 {
+		return nil
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	desired := d.Get("initial_node_count").(int)
+	for len(oldNodes) > 0 {
+		batchSize := strategy.MaxUnavailable + strategy.MaxSurge
+		if batchSize > len(oldNodes) {
+			batchSize = len(oldNodes)
+		}
+		surge := strategy.MaxSurge
+		if surge > batchSize {
+			surge = batchSize
+		}
+		batch := oldNodes[:batchSize]
+
+		if surge > 0 {
+			log.Printf("[DEBUG] Scaling out CCE node pool (%s) with %d new nodes", d.Id(), surge)
+			if err := scaleNodePool(ctx, d, client, desired+surge); err != nil {
+				return err
+			}
+		}
+
+		nodeIDs := make([]string, len(batch))
+		for i, n := range batch {
+			nodeIDs[i] = n.Metadata.Id
+		}
+		log.Printf("[DEBUG] Replacing the nodes (%s) of CCE node pool (%s)", strings.Join(nodeIDs, ","), d.Id())
+		if err := drainNodes(ctx, client, clusterID, nodeIDs, strategy.DrainTimeout); err != nil {
+			return err
+		}
+		if err := deleteNodes(ctx, d, client, clusterID, nodeIDs); err != nil {
+			return err
+		}
+		if err := scaleNodePool(ctx, d, client, desired); err != nil {
+			return err
+		}
+
+		oldNodes = oldNodes[batchSize:]
+	}
+	return nil
+}
+
+// scaleNodePool changes the node count of the node pool and waits for all nodes of the node pool to become active.
+func scaleNodePool(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient, count int) error {
+	clusterID := d.Get("cluster_id").(string)
+	updateOpts, err := buildNodePoolUpdateOpts(d, count)
+	if err != nil {
+		return err
+	}
+	_, err = nodepools.Update(client, clusterID, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error scaling CCE node pool (%s) to %d nodes: %s", d.Id(), count, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Pending"},
+		Target:       []string{"Active"},
+		Refresh:      waitForNodePoolNodesActive(client, clusterID, d.Id(), count),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        30 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the nodes of CCE node pool (%s) to become active: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func waitForNodePoolNodesActive(client *golangsdk.ServiceClient, clusterID, nodePoolID string,
+	count int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pool, err := nodepools.Get(client, clusterID, nodePoolID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		if pool.Status.Phase == "Synchronizing" {
+			return pool, "Pending", nil
+		}
+
+		poolNodes, err := listNodePoolNodes(client, clusterID, nodePoolID)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(poolNodes) != count {
+			return poolNodes, "Pending", nil
+		}
+		for _, n := range poolNodes {
+			if n.Status.Phase == "Error" {
+				return poolNodes, "", fmt.Errorf("the node %s (%s) is in Error status", n.Metadata.Name, n.Metadata.Id)
+			}
+			if n.Status.Phase != "Active" {
+				return poolNodes, "Pending", nil
+			}
+		}
+		return poolNodes, "Active", nil
+	}
+}
+
+// drainNodes cordons the nodes and evicts the pods running on them, the DaemonSet pods are ignored.
+func drainNodes(ctx context.Context, client *golangsdk.ServiceClient, clusterID string, nodeIDs []string,
+	timeout time.Duration) error {
+	drainPath := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/operation/drain"
+	drainPath = strings.ReplaceAll(drainPath, "{project_id}", client.ProjectID)
+	drainPath = strings.ReplaceAll(drainPath, "{cluster_id}", clusterID)
+
+	drainOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"apiVersion": "v3",
+			"kind":       "DrainNodesTask",
+			"spec": map[string]interface{}{
+				"nodes":              nodeIDs,
+				"ignoreDaemonSets":   true,
+				"deleteEmptyDirPods": true,
+				"timeoutSeconds":     int(timeout.Seconds()),
+			},
+		},
+	}
+	drainResp, err := client.Request("POST", drainPath, &drainOpt)
+	if err != nil {
+		return fmt.Errorf("error draining the nodes (%s): %s", strings.Join(nodeIDs, ","), err)
+	}
+	drainRespBody, err := utils.FlattenResponse(drainResp)
+	if err != nil {
+		return err
+	}
+
+	jobID := utils.PathSearch("status.jobID || jobID", drainRespBody, "").(string)
+	if jobID == "" {
+		return nil
+	}
+
+	stateJob := &resource.StateChangeConf{
+		Pending:      []string{"Initializing", "Running"},
+		Target:       []string{"Success"},
+		Refresh:      waitForJobStatus(client, jobID),
+		Timeout:      timeout + 5*time.Minute,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	v, err := stateJob.WaitForStateContext(ctx)
+	if err != nil {
+		if job, ok := v.(*nodes.Job); ok {
+			return fmt.Errorf("error waiting for the drain job (%s) to become success: %s, reason: %s",
+				jobID, err, job.Status.Reason)
+		}
+		return fmt.Errorf("error waiting for the drain job (%s) to become success: %s", jobID, err)
+	}
+	return nil
+}
+
+func deleteNodes(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient, clusterID string,
+	nodeIDs []string) error {
+	for _, nodeID := range nodeIDs {
+		if err := nodes.Delete(client, clusterID, nodeID).ExtractErr(); err != nil {
+			return fmt.Errorf("error deleting CCE node (%s): %s", nodeID, err)
+		}
+	}
+
+	for _, nodeID := range nodeIDs {
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"Deleting"},
+			Target:       []string{"Deleted"},
+			Refresh:      waitForNodeDelete(client, clusterID, nodeID),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        60 * time.Second,
+			PollInterval: 20 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for CCE node (%s) to be deleted: %s", nodeID, err)
+		}
+	}
+	return nil
+}
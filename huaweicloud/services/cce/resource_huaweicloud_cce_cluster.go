@@ -82,13 +82,11 @@ func ResourceCluster() *schema.Resource {
 			"flavor_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"cluster_version": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ForceNew:         true,
 				DiffSuppressFunc: utils.SuppressVersionDiffs,
 			},
 			"cluster_type": {
@@ -280,6 +278,10 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"upgrade_task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"certificate_clusters": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -770,6 +772,38 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("flavor_id") {
+		if err = resourceClusterResize(ctx, d, config, cceClient); err != nil {
+			// keep the previous flavor in the state so that the resize can be retried
+			oldFlavor, _ := d.GetChange("flavor_id")
+			mErr := multierror.Append(err, d.Set("flavor_id", oldFlavor))
+			return diag.FromErr(mErr.ErrorOrNil())
+		}
+	}
+
+	if d.HasChange("cluster_version") {
+		oldVersion, _ := d.GetChange("cluster_version")
+		if diags := resourceClusterPreCheck(ctx, d, cceClient); diags.HasError() {
+			if err = d.Set("cluster_version", oldVersion); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+			return diags
+		}
+
+		var mErr *multierror.Error
+		taskID, err := resourceClusterUpgrade(ctx, d, cceClient)
+		if taskID != "" {
+			mErr = multierror.Append(mErr, d.Set("upgrade_task_id", taskID))
+		}
+		if err != nil {
+			// keep the previous version in the state so that the upgrade can be retried
+			mErr = multierror.Append(mErr, err, d.Set("cluster_version", oldVersion))
+		}
+		if err = mErr.ErrorOrNil(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceClusterRead(ctx, d, meta)
 }
 
@@ -0,0 +1,200 @@
+package cce
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The logs of the control plane components are reported to the LTS log group and stream created by CCE.
+var clusterLogComponents = []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler", "audit"}
+
+func ResourceClusterLogConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterLogConfigPut,
+		ReadContext:   resourceClusterLogConfigRead,
+		UpdateContext: resourceClusterLogConfigPut,
+		DeleteContext: resourceClusterLogConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ttl_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 30),
+			},
+			"log_configs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(clusterLogComponents, false),
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildClusterLogConfigURL(client *golangsdk.ServiceClient, clusterID string) string {
+	requestPath := client.Endpoint + "api/v3/projects/{project_id}/cluster/{cluster_id}/log-configs"
+	requestPath = strings.ReplaceAll(requestPath, "{project_id}", client.ProjectID)
+	requestPath = strings.ReplaceAll(requestPath, "{cluster_id}", clusterID)
+	return requestPath
+}
+
+// buildClusterLogConfigs returns the switches of all components, the components which are not specified are
+// disabled, so that the logs enabled outside of Terraform are turned off.
+func buildClusterLogConfigs(rawConfigs []interface{}) []map[string]interface{} {
+	enabled := make(map[string]bool)
+	for _, v := range rawConfigs {
+		raw := v.(map[string]interface{})
+		enabled[raw["name"].(string)] = raw["enable"].(bool)
+	}
+
+	result := make([]map[string]interface{}, len(clusterLogComponents))
+	for i, name := range clusterLogComponents {
+		result[i] = map[string]interface{}{
+			"name":   name,
+			"enable": enabled[name],
+		}
+	}
+	return result
+}
+
+func updateClusterLogConfig(client *golangsdk.ServiceClient, clusterID string, ttl int,
+	logConfigs []map[string]interface{}) error {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"ttl_in_days": ttl,
+			"log_configs": logConfigs,
+		},
+	}
+	_, err := client.Request("PUT", buildClusterLogConfigURL(client, clusterID), &opt)
+	return err
+}
+
+func resourceClusterLogConfigPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	logConfigs := buildClusterLogConfigs(d.Get("log_configs").(*schema.Set).List())
+	err = updateClusterLogConfig(client, clusterID, d.Get("ttl_in_days").(int), logConfigs)
+	if err != nil {
+		return diag.Errorf("error configuring the logs of CCE cluster (%s): %s", clusterID, err)
+	}
+	d.SetId(clusterID)
+
+	return resourceClusterLogConfigRead(ctx, d, meta)
+}
+
+// flattenClusterLogConfigs returns the enabled components and the components configured in the state, so the
+// components which are not managed and still disabled will not cause a diff.
+func flattenClusterLogConfigs(d *schema.ResourceData, respBody interface{}) []map[string]interface{} {
+	configured := make(map[string]bool)
+	for _, v := range d.Get("log_configs").(*schema.Set).List() {
+		configured[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	logConfigs := utils.PathSearch("log_configs", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, 0, len(logConfigs))
+	for _, v := range logConfigs {
+		name := utils.PathSearch("name", v, "").(string)
+		enable := utils.PathSearch("enable", v, false).(bool)
+		if enable || configured[name] {
+			result = append(result, map[string]interface{}{
+				"name":   name,
+				"enable": enable,
+			})
+		}
+	}
+	return result
+}
+
+func resourceClusterLogConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", buildClusterLogConfigURL(client, d.Id()), &opt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CCE cluster log config")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("ttl_in_days", utils.PathSearch("ttl_in_days", respBody, nil)),
+		d.Set("log_configs", flattenClusterLogConfigs(d, respBody)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE cluster log config fields: %s", err)
+	}
+	return nil
+}
+
+func resourceClusterLogConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	// the log config cannot be deleted, so all the components are disabled
+	err = updateClusterLogConfig(client, d.Id(), d.Get("ttl_in_days").(int), buildClusterLogConfigs(nil))
+	if err != nil {
+		return diag.Errorf("error disabling the logs of CCE cluster (%s): %s", d.Id(), err)
+	}
+	return nil
+}
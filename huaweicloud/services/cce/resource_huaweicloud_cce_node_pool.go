@@ -32,8 +32,11 @@ func ResourceCCENodePool() *schema.Resource {
 			StateContext: resourceCCENodePoolV3Import,
 		},
 
+		CustomizeDiff: resourceNodePoolCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -60,7 +63,6 @@ func ResourceCCENodePool() *schema.Resource {
 			"flavor_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -76,43 +78,36 @@ func ResourceCCENodePool() *schema.Resource {
 			"root_volume": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"size": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"volumetype": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"hw_passthrough": {
 							Type:        schema.TypeBool,
 							Optional:    true,
-							ForceNew:    true,
 							Description: "schema: Internal",
 						},
 						"extend_param": {
 							Type:       schema.TypeString,
 							Optional:   true,
-							ForceNew:   true,
 							Deprecated: "use extend_params instead",
 						},
 						"extend_params": {
 							Type:     schema.TypeMap,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"kms_key_id": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					}},
 			},
@@ -167,7 +162,6 @@ func ResourceCCENodePool() *schema.Resource {
 			"os": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"key_pair": {
@@ -356,7 +350,6 @@ func ResourceCCENodePool() *schema.Resource {
 			"runtime": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"docker", "containerd",
@@ -414,6 +407,7 @@ func ResourceCCENodePool() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"upgrade_strategy": upgradeStrategySchema(),
 			"current_node_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -751,21 +745,14 @@ func flattenStorage(storageRaw *nodes.StorageSpec) []map[string]interface{} {
 	}
 }
 
-func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*config.Config)
-	nodePoolClient, err := config.CceV3Client(config.GetRegion(d))
-	if err != nil {
-		return fmtp.DiagErrorf("Error creating HuaweiCloud CCE client: %s", err)
-	}
-
-	initialNodeCount := d.Get("initial_node_count").(int)
+func buildNodePoolUpdateOpts(d *schema.ResourceData, initialNodeCount int) (nodepools.UpdateOpts, error) {
 	var loginSpec nodes.LoginSpec
 	if common.HasFilledOpt(d, "key_pair") {
 		loginSpec = nodes.LoginSpec{SshKey: d.Get("key_pair").(string)}
 	} else if common.HasFilledOpt(d, "password") {
 		password, err := utils.TryPasswordEncrypt(d.Get("password").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return nodepools.UpdateOpts{}, err
 		}
 		loginSpec = nodes.LoginSpec{
 			UserPassword: nodes.UserPassword{
@@ -793,6 +780,7 @@ func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 			NodeTemplate: nodes.Spec{
 				Flavor:      d.Get("flavor_id").(string),
 				Az:          d.Get("availability_zone").(string),
+				Os:          d.Get("os").(string),
 				Login:       loginSpec,
 				RootVolume:  buildResourceNodeRootVolume(d),
 				DataVolumes: buildResourceNodeDataVolume(d),
@@ -805,7 +793,36 @@ func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 		},
 	}
 
+	if v, ok := d.GetOk("runtime"); ok {
+		updateOpts.Spec.NodeTemplate.RunTime = &nodes.RunTimeSpec{
+			Name: v.(string),
+		}
+	}
+	return updateOpts, nil
+}
+
+func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	nodePoolClient, err := config.CceV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloud CCE client: %s", err)
+	}
+
 	clusterid := d.Get("cluster_id").(string)
+	// the node template is changed, the nodes created with the old template will be replaced in batches
+	var oldNodes []nodes.Nodes
+	if d.HasChanges(nodePoolRollingKeys...) {
+		oldNodes, err = listNodePoolNodes(nodePoolClient, clusterid, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	updateOpts, err := buildNodePoolUpdateOpts(d, d.Get("initial_node_count").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, err = nodepools.Update(nodePoolClient, clusterid, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmtp.DiagErrorf("Error updating HuaweiCloud Node Node Pool: %s", err)
@@ -824,6 +841,10 @@ func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return fmtp.DiagErrorf("Error updating HuaweiCloud CCE Node Pool: %s", err)
 	}
 
+	if err = rollNodePoolNodes(ctx, d, nodePoolClient, oldNodes); err != nil {
+		return diag.Errorf("error replacing the nodes of CCE node pool (%s): %s", d.Id(), err)
+	}
+
 	return resourceCCENodePoolRead(ctx, d, meta)
 }
 
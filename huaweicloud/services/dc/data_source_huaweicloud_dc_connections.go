@@ -0,0 +1,227 @@
+package dc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the direct connections are located.",
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The direct connection ID used to query specified connection.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name used to filter the direct connections.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"standard", "hosting", "hosted",
+				}, false),
+				Description: "The type used to filter the direct connections.",
+			},
+			"port_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The port type used to filter the direct connections.",
+			},
+			"hosting_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the operations connection used to filter the hosted connections.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The status used to filter the direct connections.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID used to filter the direct connections.",
+			},
+			// Attributes
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The direct connection ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the direct connection.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the direct connection.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the direct connection.",
+						},
+						"port_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The port type of the direct connection.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The bandwidth of the direct connection, in Mbit/s.",
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The access location of the direct connection.",
+						},
+						"peer_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The location of the on-premises facility at the other end of the connection.",
+						},
+						"carrier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The carrier (line provider) of the direct connection.",
+						},
+						"hosting_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the operations connection on which the hosted connection is created.",
+						},
+						"vlan": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The VLAN allocated to the hosted connection.",
+						},
+						"device_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The attributed device ID.",
+						},
+						"charge_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The billing mode of the direct connection.",
+						},
+						"enterprise_project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The enterprise project ID to which the direct connection belongs.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current status of the direct connection.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation time of the direct connection.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenConnections(d *schema.ResourceData, all []interface{}) []map[string]interface{} {
+	filters := map[string]string{
+		"name":      d.Get("name").(string),
+		"type":      d.Get("type").(string),
+		"port_type": d.Get("port_type").(string),
+	}
+
+	result := make([]map[string]interface{}, 0, len(all))
+	for _, connection := range all {
+		if !utils.IsFieldsMatched(connection, filters) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    utils.PathSearch("id", connection, nil),
+			"name":                  utils.PathSearch("name", connection, nil),
+			"description":           utils.PathSearch("description", connection, nil),
+			"type":                  utils.PathSearch("type", connection, nil),
+			"port_type":             utils.PathSearch("port_type", connection, nil),
+			"bandwidth":             utils.PathSearch("bandwidth", connection, nil),
+			"location":              utils.PathSearch("location", connection, nil),
+			"peer_location":         utils.PathSearch("peer_location", connection, nil),
+			"carrier":               utils.PathSearch("provider", connection, nil),
+			"hosting_id":            utils.PathSearch("hosting_id", connection, nil),
+			"vlan":                  utils.PathSearch("vlan", connection, nil),
+			"device_id":             utils.PathSearch("device_id", connection, nil),
+			"charge_mode":           utils.PathSearch("charge_mode", connection, nil),
+			"enterprise_project_id": utils.PathSearch("enterprise_project_id", connection, nil),
+			"status":                utils.PathSearch("status", connection, nil),
+			"created_at":            utils.PathSearch("create_time", connection, nil),
+		})
+	}
+	return result
+}
+
+func dataSourceConnectionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	queryParams := utils.BuildQueryParams(map[string]interface{}{
+		"id":                    d.Get("connection_id"),
+		"hosting_id":            d.Get("hosting_id"),
+		"status":                d.Get("status"),
+		"enterprise_project_id": d.Get("enterprise_project_id"),
+	})
+	path := client.Endpoint + "v3/{project_id}/dcaas/direct-connects?limit=100" + queryParams
+	connections, err := utils.ListWithMarker(client, path, "direct_connects")
+	if err != nil {
+		return diag.Errorf("error retrieving direct connections: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("connections", flattenConnections(d, connections)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving direct connection list fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package dc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceVirtualInterfaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVirtualInterfacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the virtual interfaces are located.",
+			},
+			"interface_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The virtual interface ID used to query specified interface.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name used to filter the virtual interfaces.",
+			},
+			"direct_connect_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the direct connection used to filter the virtual interfaces.",
+			},
+			"vgw_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the virtual gateway used to filter the virtual interfaces.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The status used to filter the virtual interfaces.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID used to filter the virtual interfaces.",
+			},
+			// Attributes
+			"virtual_interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The virtual interface ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the virtual interface.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the virtual interface.",
+						},
+						"direct_connect_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the direct connection associated with the virtual interface.",
+						},
+						"vgw_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the virtual gateway to which the virtual interface is connected.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the virtual interface.",
+						},
+						"route_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The route mode of the virtual interface.",
+						},
+						"vlan": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The VLAN for constom side.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ingress bandwidth size of the virtual interface.",
+						},
+						"remote_ep_group": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The CIDR list of remote subnets.",
+						},
+						"local_gateway_v4_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv4 address of the virtual interface in cloud side.",
+						},
+						"remote_gateway_v4_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IPv4 address of the virtual interface in client side.",
+						},
+						"asn": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The local BGP ASN in client side.",
+						},
+						"enable_bfd": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the Bidirectional Forwarding Detection (BFD) function is enabled.",
+						},
+						"enable_nqa": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the Network Quality Analysis (NQA) function is enabled.",
+						},
+						"enterprise_project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The enterprise project ID to which the virtual interface belongs.",
+						},
+						"device_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The attributed device ID.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current status of the virtual interface.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation time of the virtual interface.",
+						},
+						"vif_peers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        vifPeerSchema(),
+							Description: "The peer information of the virtual interface, including the BGP status.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenVirtualInterfaces(d *schema.ResourceData, all []interface{}) []map[string]interface{} {
+	filters := map[string]string{
+		"name":              d.Get("name").(string),
+		"direct_connect_id": d.Get("direct_connect_id").(string),
+		"vgw_id":            d.Get("vgw_id").(string),
+	}
+
+	result := make([]map[string]interface{}, 0, len(all))
+	for _, vif := range all {
+		if !utils.IsFieldsMatched(vif, filters) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    utils.PathSearch("id", vif, nil),
+			"name":                  utils.PathSearch("name", vif, nil),
+			"description":           utils.PathSearch("description", vif, nil),
+			"direct_connect_id":     utils.PathSearch("direct_connect_id", vif, nil),
+			"vgw_id":                utils.PathSearch("vgw_id", vif, nil),
+			"type":                  utils.PathSearch("type", vif, nil),
+			"route_mode":            utils.PathSearch("route_mode", vif, nil),
+			"vlan":                  utils.PathSearch("vlan", vif, nil),
+			"bandwidth":             utils.PathSearch("bandwidth", vif, nil),
+			"remote_ep_group":       utils.PathSearch("remote_ep_group", vif, nil),
+			"local_gateway_v4_ip":   utils.PathSearch("local_gateway_v4_ip", vif, nil),
+			"remote_gateway_v4_ip":  utils.PathSearch("remote_gateway_v4_ip", vif, nil),
+			"asn":                   utils.PathSearch("bgp_asn", vif, nil),
+			"enable_bfd":            utils.PathSearch("enable_bfd", vif, false),
+			"enable_nqa":            utils.PathSearch("enable_nqa", vif, false),
+			"enterprise_project_id": utils.PathSearch("enterprise_project_id", vif, nil),
+			"device_id":             utils.PathSearch("device_id", vif, nil),
+			"status":                utils.PathSearch("status", vif, nil),
+			"created_at":            utils.PathSearch("create_time", vif, nil),
+			"vif_peers": flattenVifPeers(
+				utils.PathSearch("vif_peers", vif, make([]interface{}, 0)).([]interface{})),
+		})
+	}
+	return result
+}
+
+func dataSourceVirtualInterfacesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	queryParams := utils.BuildQueryParams(map[string]interface{}{
+		"id":                    d.Get("interface_id"),
+		"status":                d.Get("status"),
+		"enterprise_project_id": d.Get("enterprise_project_id"),
+	})
+	path := client.Endpoint + "v3/{project_id}/dcaas/virtual-interfaces?limit=100" + queryParams
+	vifs, err := utils.ListWithMarker(client, path, "virtual_interfaces")
+	if err != nil {
+		return diag.Errorf("error retrieving virtual interfaces: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("virtual_interfaces", flattenVirtualInterfaces(d, vifs)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving virtual interface list fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,401 @@
+package dc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// connectionType describes the API of a kind of direct connection.
+type connectionType struct {
+	// The URL path of the typed connections, such as 'direct-connects'.
+	Path string
+	// The key of the connection object in the request and response bodies, such as 'direct_connect'.
+	BodyKey string
+}
+
+var (
+	standardConnectionType = connectionType{
+		Path:    "direct-connects",
+		BodyKey: "direct_connect",
+	}
+	// The hosted connections are created by the partner through the operations connection (hosting connection).
+	hostedConnectionType = connectionType{
+		Path:    "hosted-connects",
+		BodyKey: "hosted_connect",
+	}
+)
+
+func ResourceDirectConnect() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectConnectCreate,
+		ReadContext:   resourceDirectConnectRead,
+		UpdateContext: resourceDirectConnectUpdate,
+		DeleteContext: resourceDirectConnectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region where the direct connection is located.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile("^[\u4e00-\u9fa5\\w-.]*$"),
+						"Only chinese and english letters, digits, hyphens (-), underscores (_) and dots (.) are "+
+							"allowed."),
+					validation.StringLenBetween(1, 64),
+				),
+				Description: "The name of the direct connection.",
+			},
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The bandwidth of the direct connection, in Mbit/s.",
+			},
+			"port_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"1G", "10G", "40G", "100G",
+				}, false),
+				ConflictsWith: []string{"hosting_id"},
+				Description:   "The port type of the standard direct connection.",
+			},
+			"location": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"hosting_id"},
+				Description:   "The access location of the standard direct connection.",
+			},
+			"carrier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"hosting_id"},
+				Description:   "The carrier (line provider) of the standard direct connection.",
+			},
+			"hosting_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"vlan", "resource_tenant_id"},
+				Description:  "The ID of the operations connection on which the hosted connection is created.",
+			},
+			"vlan": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 3999),
+				Description:  "The VLAN allocated to the hosted connection.",
+			},
+			"resource_tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The project ID of the tenant to which the hosted connection is allocated.",
+			},
+			"peer_location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The location of the on-premises facility at the other end of the connection.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"The angle brackets (< and >) are not allowed."),
+					validation.StringLenBetween(0, 128),
+				),
+				Description: "The description of the direct connection.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The enterprise project ID to which the direct connection belongs.",
+			},
+			// Attributes
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the direct connection.",
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attributed device ID.",
+			},
+			"charge_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The billing mode of the direct connection.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the direct connection.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the direct connection.",
+			},
+		},
+	}
+}
+
+func getConnectionTypeByHostingId(hostingId string) connectionType {
+	if hostingId != "" {
+		return hostedConnectionType
+	}
+	return standardConnectionType
+}
+
+func getConnectionType(d *schema.ResourceData) connectionType {
+	return getConnectionTypeByHostingId(d.Get("hosting_id").(string))
+}
+
+func buildDirectConnectPath(client *golangsdk.ServiceClient, t connectionType) string {
+	path := client.Endpoint + "v3/{project_id}/dcaas/" + t.Path
+	return strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+}
+
+func buildDirectConnectCreateBodyParams(d *schema.ResourceData, cfg *config.Config) (map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"name":          d.Get("name"),
+		"bandwidth":     d.Get("bandwidth"),
+		"peer_location": utils.ValueIngoreEmpty(d.Get("peer_location")),
+		"description":   utils.ValueIngoreEmpty(d.Get("description")),
+	}
+
+	if d.Get("hosting_id").(string) != "" {
+		params["hosting_id"] = d.Get("hosting_id")
+		params["vlan"] = d.Get("vlan")
+		params["resource_tenant_id"] = d.Get("resource_tenant_id")
+		return params, nil
+	}
+
+	portType := d.Get("port_type").(string)
+	location := d.Get("location").(string)
+	carrier := d.Get("carrier").(string)
+	if portType == "" || location == "" || carrier == "" {
+		return nil, fmt.Errorf("the port_type, location and carrier are required for the standard direct connection")
+	}
+	params["port_type"] = portType
+	params["location"] = location
+	params["provider"] = carrier
+	params["enterprise_project_id"] = utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg))
+	return params, nil
+}
+
+func resourceDirectConnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	t := getConnectionType(d)
+	params, err := buildDirectConnectCreateBodyParams(d, cfg)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 202},
+		JSONBody: map[string]interface{}{
+			t.BodyKey: utils.RemoveNil(params),
+		},
+	}
+	resp, err := client.Request("POST", buildDirectConnectPath(client, t), &opt)
+	if err != nil {
+		return diag.Errorf("error creating direct connection: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connectionId := utils.PathSearch(t.BodyKey+".id", respBody, "").(string)
+	if connectionId == "" {
+		return diag.Errorf("unable to find the direct connection ID from the API response")
+	}
+	d.SetId(connectionId)
+
+	return resourceDirectConnectRead(ctx, d, meta)
+}
+
+// GetDirectConnect queries the direct connection by its ID, the hosted connection is queried if the hosting ID is
+// specified.
+func GetDirectConnect(client *golangsdk.ServiceClient, connectionId, hostingId string) (interface{}, error) {
+	t := getConnectionTypeByHostingId(hostingId)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", buildDirectConnectPath(client, t)+"/"+connectionId, &opt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch(t.BodyKey, respBody, nil), nil
+}
+
+func resourceDirectConnectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	connection, err := GetDirectConnect(client, d.Id(), d.Get("hosting_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "direct connection")
+	}
+	log.Printf("[DEBUG] The response of direct connection is: %#v", connection)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", connection, nil)),
+		d.Set("bandwidth", utils.PathSearch("bandwidth", connection, nil)),
+		d.Set("port_type", utils.PathSearch("port_type", connection, nil)),
+		d.Set("location", utils.PathSearch("location", connection, nil)),
+		d.Set("carrier", utils.PathSearch("provider", connection, nil)),
+		d.Set("hosting_id", utils.PathSearch("hosting_id", connection, nil)),
+		d.Set("vlan", utils.PathSearch("vlan", connection, nil)),
+		d.Set("peer_location", utils.PathSearch("peer_location", connection, nil)),
+		d.Set("description", utils.PathSearch("description", connection, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("enterprise_project_id", connection, nil)),
+		d.Set("type", utils.PathSearch("type", connection, nil)),
+		d.Set("device_id", utils.PathSearch("device_id", connection, nil)),
+		d.Set("charge_mode", utils.PathSearch("charge_mode", connection, nil)),
+		d.Set("status", utils.PathSearch("status", connection, nil)),
+		d.Set("created_at", utils.PathSearch("create_time", connection, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving direct connection fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDirectConnectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	connectionId := d.Id()
+	if d.HasChanges("name", "description", "bandwidth", "peer_location") {
+		t := getConnectionType(d)
+		opt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 202},
+			JSONBody: map[string]interface{}{
+				t.BodyKey: utils.RemoveNil(map[string]interface{}{
+					"name":          d.Get("name"),
+					"description":   d.Get("description"),
+					"bandwidth":     d.Get("bandwidth"),
+					"peer_location": utils.ValueIngoreEmpty(d.Get("peer_location")),
+				}),
+			},
+		}
+		_, err = client.Request("PUT", buildDirectConnectPath(client, t)+"/"+connectionId, &opt)
+		if err != nil {
+			return diag.Errorf("error updating direct connection (%s): %s", connectionId, err)
+		}
+	}
+
+	return resourceDirectConnectRead(ctx, d, meta)
+}
+
+func directConnectDeleteRefreshFunc(client *golangsdk.ServiceClient, connectionId,
+	hostingId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		connection, err := GetDirectConnect(client, connectionId, hostingId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "deleted", "COMPLETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("status", connection, "").(string)
+		if status == "ERROR" {
+			return connection, "", fmt.Errorf("unexpected status '%s'", status)
+		}
+		return connection, "PENDING", nil
+	}
+}
+
+func resourceDirectConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	connectionId := d.Id()
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202, 204},
+	}
+	_, err = client.Request("DELETE", buildDirectConnectPath(client, getConnectionType(d))+"/"+connectionId, &opt)
+	if err != nil {
+		return diag.Errorf("error deleting direct connection (%s): %s", connectionId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      directConnectDeleteRefreshFunc(client, connectionId, d.Get("hosting_id").(string)),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the direct connection (%s) to be deleted: %s", connectionId, err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"reflect"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -238,6 +239,70 @@ func ResourceVirtualInterface() *schema.Resource {
 				Computed:    true,
 				Description: "The creation time of the virtual interface.",
 			},
+			"vif_peers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        vifPeerSchema(),
+				Description: "The peer information of the virtual interface, including the BGP status.",
+			},
+		},
+	}
+}
+
+func vifPeerSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the VIF peer.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the VIF peer.",
+			},
+			"address_family": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address family type of the VIF peer.",
+			},
+			"local_gateway_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the VIF peer in cloud side.",
+			},
+			"remote_gateway_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the VIF peer in client side.",
+			},
+			"remote_ep_group": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CIDR list of remote subnets.",
+			},
+			"bgp_asn": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The BGP ASN in client side.",
+			},
+			"bgp_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The BGP protocol status of the VIF peer.",
+			},
+			"bgp_route_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of the BGP routes of the VIF peer.",
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attributed device ID.",
+			},
 		},
 	}
 }
@@ -293,7 +358,7 @@ func resourceVirtualInterfaceRead(_ context.Context, d *schema.ResourceData, met
 	}
 
 	interfaceId := d.Id()
-	resp, err := interfaces.Get(client, interfaceId)
+	resp, err := getVirtualInterface(client, interfaceId)
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "virtual interface")
 	}
@@ -301,30 +366,31 @@ func resourceVirtualInterfaceRead(_ context.Context, d *schema.ResourceData, met
 
 	mErr := multierror.Append(nil,
 		d.Set("region", cfg.GetRegion(d)),
-		d.Set("vgw_id", resp.VgwId),
-		d.Set("type", resp.Type),
-		d.Set("route_mode", resp.RouteMode),
-		d.Set("vlan", resp.Vlan),
-		d.Set("bandwidth", resp.Bandwidth),
-		d.Set("remote_ep_group", resp.RemoteEpGroup),
-		d.Set("name", resp.Name),
-		d.Set("description", resp.Description),
-		d.Set("direct_connect_id", resp.DirectConnectId),
-		d.Set("service_type", resp.ServiceType),
-		d.Set("local_gateway_v4_ip", resp.LocalGatewayV4Ip),
-		d.Set("remote_gateway_v4_ip", resp.RemoteGatewayV4Ip),
-		d.Set("address_family", resp.AddressFamily),
-		d.Set("local_gateway_v6_ip", resp.LocalGatewayV6Ip),
-		d.Set("remote_gateway_v6_ip", resp.RemoteGatewayV6Ip),
-		d.Set("asn", resp.BgpAsn),
-		d.Set("bgp_md5", resp.BgpMd5),
-		d.Set("enable_bfd", resp.EnableBfd),
-		d.Set("enable_nqa", resp.EnableNqa),
-		d.Set("lag_id", resp.LagId),
-		d.Set("enterprise_project_id", resp.EnterpriseProjectId),
-		d.Set("device_id", resp.DeviceId),
-		d.Set("status", resp.Status),
-		d.Set("created_at", resp.CreatedAt),
+		d.Set("vgw_id", utils.PathSearch("vgw_id", resp, nil)),
+		d.Set("type", utils.PathSearch("type", resp, nil)),
+		d.Set("route_mode", utils.PathSearch("route_mode", resp, nil)),
+		d.Set("vlan", utils.PathSearch("vlan", resp, nil)),
+		d.Set("bandwidth", utils.PathSearch("bandwidth", resp, nil)),
+		d.Set("remote_ep_group", utils.PathSearch("remote_ep_group", resp, nil)),
+		d.Set("name", utils.PathSearch("name", resp, nil)),
+		d.Set("description", utils.PathSearch("description", resp, nil)),
+		d.Set("direct_connect_id", utils.PathSearch("direct_connect_id", resp, nil)),
+		d.Set("service_type", utils.PathSearch("service_type", resp, nil)),
+		d.Set("local_gateway_v4_ip", utils.PathSearch("local_gateway_v4_ip", resp, nil)),
+		d.Set("remote_gateway_v4_ip", utils.PathSearch("remote_gateway_v4_ip", resp, nil)),
+		d.Set("address_family", utils.PathSearch("address_family", resp, nil)),
+		d.Set("local_gateway_v6_ip", utils.PathSearch("local_gateway_v6_ip", resp, nil)),
+		d.Set("remote_gateway_v6_ip", utils.PathSearch("remote_gateway_v6_ip", resp, nil)),
+		d.Set("asn", utils.PathSearch("bgp_asn", resp, nil)),
+		d.Set("bgp_md5", utils.PathSearch("bgp_md5", resp, nil)),
+		d.Set("enable_bfd", utils.PathSearch("enable_bfd", resp, nil)),
+		d.Set("enable_nqa", utils.PathSearch("enable_nqa", resp, nil)),
+		d.Set("lag_id", utils.PathSearch("lag_id", resp, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("enterprise_project_id", resp, nil)),
+		d.Set("device_id", utils.PathSearch("device_id", resp, nil)),
+		d.Set("status", utils.PathSearch("status", resp, nil)),
+		d.Set("created_at", utils.PathSearch("create_time", resp, nil)),
+		d.Set("vif_peers", flattenVifPeers(utils.PathSearch("vif_peers", resp, make([]interface{}, 0)).([]interface{}))),
 	)
 
 	if err = mErr.ErrorOrNil(); err != nil {
@@ -333,6 +399,46 @@ func resourceVirtualInterfaceRead(_ context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// getVirtualInterface queries the virtual interface through the raw request, because the SDK parses the peers from
+// the wrong key ('vif_peer' instead of 'vif_peers').
+func getVirtualInterface(client *golangsdk.ServiceClient, interfaceId string) (interface{}, error) {
+	path := client.Endpoint + "v3/{project_id}/dcaas/virtual-interfaces/{interface_id}"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{interface_id}", interfaceId)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("virtual_interface", respBody, nil), nil
+}
+
+func flattenVifPeers(peers []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(peers))
+	for _, peer := range peers {
+		result = append(result, map[string]interface{}{
+			"id":                utils.PathSearch("id", peer, nil),
+			"name":              utils.PathSearch("name", peer, nil),
+			"address_family":    utils.PathSearch("address_family", peer, nil),
+			"local_gateway_ip":  utils.PathSearch("local_gateway_ip", peer, nil),
+			"remote_gateway_ip": utils.PathSearch("remote_gateway_ip", peer, nil),
+			"remote_ep_group":   utils.PathSearch("remote_ep_group", peer, nil),
+			"bgp_asn":           utils.PathSearch("bgp_asn", peer, nil),
+			"bgp_status":        utils.PathSearch("bgp_status", peer, nil),
+			"bgp_route_limit":   utils.PathSearch("bgp_route_limit", peer, nil),
+			"device_id":         utils.PathSearch("device_id", peer, nil),
+		})
+	}
+	return result
+}
+
 func closeVirtualInterfaceNetworkDetection(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
 	var (
 		interfaceId = d.Id()
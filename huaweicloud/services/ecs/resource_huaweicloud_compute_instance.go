@@ -3,6 +3,7 @@ package ecs
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"strings"
@@ -46,6 +47,7 @@ func ResourceComputeInstance() *schema.Resource {
 		ReadContext:   resourceComputeInstanceRead,
 		UpdateContext: resourceComputeInstanceUpdate,
 		DeleteContext: resourceComputeInstanceDelete,
+		CustomizeDiff: resourceComputeInstanceCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceComputeInstanceImportState,
@@ -79,20 +81,25 @@ func ResourceComputeInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			// image_id, image_name and user_data will create a new resource if rebuild_on_image_change is not enabled,
+			// please refer to resourceComputeInstanceCustomizeDiff
 			"image_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("HW_IMAGE_ID", nil),
 			},
 			"image_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("HW_IMAGE_NAME", nil),
 			},
+			"rebuild_on_image_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"flavor_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -269,7 +276,6 @@ func ResourceComputeInstance() *schema.Resource {
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				// just stash the hash for state & diff comparisons
 				StateFunc: utils.HashAndHexEncode,
 			},
@@ -947,7 +953,20 @@ func resourceComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("admin_pass") {
+	// the admin_pass, key_pair and user_data are re-applied when the OS is changed or reinstalled
+	var rebuilt bool
+	if d.HasChanges("image_id", "image_name", "user_data") {
+		imsClient, err := cfg.ImageV2Client(region)
+		if err != nil {
+			return diag.Errorf("error creating image client: %s", err)
+		}
+		if err := rebuildInstance(d, cfg, ecsClient, imsClient); err != nil {
+			return diag.FromErr(err)
+		}
+		rebuilt = true
+	}
+
+	if d.HasChange("admin_pass") && !rebuilt {
 		if newPwd, ok := d.Get("admin_pass").(string); ok {
 			err := cloudservers.ChangeAdminPassword(ecsClient, d.Id(), newPwd).ExtractErr()
 			if err != nil {
@@ -1069,7 +1088,7 @@ func resourceComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// update the key_pair before power action
-	if d.HasChange("key_pair") {
+	if d.HasChange("key_pair") && !rebuilt {
 		kmsClient, err := cfg.KmsV3Client(region)
 		if err != nil {
 			return diag.Errorf("error creating KMS v3 client: %s", err)
@@ -1208,6 +1227,7 @@ func resourceComputeInstanceImportState(_ context.Context, d *schema.ResourceDat
 
 	log.Printf("[DEBUG] flatten Instance Networks: %#v", networks)
 	d.Set("network", networks)
+	d.Set("rebuild_on_image_change", false)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -1354,6 +1374,107 @@ func buildInstanceSchedulerHints(schedulerHintsRaw map[string]interface{}) cloud
 	return schedulerHints
 }
 
+// resourceComputeInstanceCustomizeDiff makes the changes of image and user_data create a new resource,
+// unless rebuild_on_image_change is enabled and the OS will be changed or reinstalled in place.
+func resourceComputeInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rebuild := d.Get("rebuild_on_image_change").(bool)
+	for _, key := range []string{"image_id", "image_name", "user_data"} {
+		if !d.HasChange(key) {
+			continue
+		}
+		if !rebuild {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the image ID and name are refreshed together after the OS is changed
+	if rebuild && d.HasChange("image_name") && !d.HasChange("image_id") {
+		return d.SetNewComputed("image_id")
+	}
+	if rebuild && d.HasChange("image_id") && !d.HasChange("image_name") {
+		return d.SetNewComputed("image_name")
+	}
+	return nil
+}
+
+func buildInstanceRebuildBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	params := map[string]interface{}{
+		"userid": utils.ValueIngoreEmpty(getOpSvcUserID(d, cfg)),
+		"mode":   "withStopServer",
+	}
+	// the key pair and the password can not be specified at the same time
+	if keyPair := d.Get("key_pair").(string); keyPair != "" {
+		params["keyname"] = keyPair
+	} else {
+		params["adminpass"] = utils.ValueIngoreEmpty(d.Get("admin_pass"))
+	}
+
+	if userData := d.Get("user_data").(string); userData != "" {
+		if _, err := base64.StdEncoding.DecodeString(userData); err != nil {
+			userData = base64.StdEncoding.EncodeToString([]byte(userData))
+		}
+		params["metadata"] = map[string]interface{}{
+			"user_data": userData,
+		}
+	}
+	return params
+}
+
+// rebuildInstance changes the OS of the instance if the image is changed, otherwise reinstalls the OS.
+// The instance ID, NICs and data disks are kept, and the admin_pass, key_pair and user_data are re-applied.
+func rebuildInstance(d *schema.ResourceData, cfg *config.Config, ecsClient, imsClient *golangsdk.ServiceClient) error {
+	imageID, err := getImageIDFromConfig(d, imsClient)
+	if err != nil {
+		return err
+	}
+
+	params := buildInstanceRebuildBodyParams(d, cfg)
+	var (
+		httpUrl = "v2/{project_id}/cloudservers/{server_id}/reinstallos"
+		body    = map[string]interface{}{"os-reinstall": params}
+	)
+	if oldImageID, _ := d.GetChange("image_id"); oldImageID.(string) != imageID {
+		httpUrl = "v2/{project_id}/cloudservers/{server_id}/changeos"
+		params["imageid"] = imageID
+		body = map[string]interface{}{"os-change": params}
+	}
+
+	requestPath := ecsClient.Endpoint + httpUrl
+	requestPath = strings.ReplaceAll(requestPath, "{project_id}", ecsClient.ProjectID)
+	requestPath = strings.ReplaceAll(requestPath, "{server_id}", d.Id())
+	requestOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(body),
+	}
+
+	log.Printf("[DEBUG] rebuild the OS of instance (%s) with image (%s)", d.Id(), imageID)
+	resp, err := ecsClient.Request("POST", requestPath, &requestOpt)
+	if err != nil {
+		return fmt.Errorf("error rebuilding the OS of instance (%s): %s", d.Id(), err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	jobID := utils.PathSearch("job_id", respBody, "").(string)
+	if jobID == "" {
+		return fmt.Errorf("error rebuilding the OS of instance (%s): job ID is not found in API response", d.Id())
+	}
+
+	timeout := int(d.Timeout(schema.TimeoutUpdate) / time.Second)
+	if err := cloudservers.WaitForJobSuccess(ecsClient, timeout, jobID); err != nil {
+		return fmt.Errorf("error waiting for the OS of instance (%s) to be rebuilt: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func getImage(client *golangsdk.ServiceClient, id, name string) (*cloudimages.Image, error) {
 	listOpts := &cloudimages.ListOpts{
 		ID:                  id,
@@ -0,0 +1,377 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// attachmentType describes the API of a kind of attachment, the VPC attachment is managed by the SDK.
+type attachmentType struct {
+	// The resource type used to filter the attachments, such as 'vpn', 'vgw' and 'peering'.
+	ResourceType string
+	// The URL path of the typed attachments under the ER instance, such as 'vpn-attachments'.
+	Path string
+	// The key of the attachment object in the request and response bodies, such as 'vpn_attachment'.
+	BodyKey string
+	// The name used in the logs and error messages.
+	Name string
+	// The argument of the attached resource ID, such as 'vpn_connection_id'. It is only set for the attachments which
+	// are created by the owner services, see resourceServiceAttachment.
+	ResourceIdKey string
+	// The name of the attached resource used in the descriptions and messages, such as 'VPN connection'.
+	ResourceName string
+}
+
+var (
+	vpnAttachmentType = attachmentType{
+		ResourceType:  "vpn",
+		Path:          "vpn-attachments",
+		BodyKey:       "vpn_attachment",
+		Name:          "VPN attachment",
+		ResourceIdKey: "vpn_connection_id",
+		ResourceName:  "VPN connection",
+	}
+	dcAttachmentType = attachmentType{
+		ResourceType:  "vgw",
+		Path:          "vgw-attachments",
+		BodyKey:       "vgw_attachment",
+		Name:          "DC attachment",
+		ResourceIdKey: "virtual_gateway_id",
+		ResourceName:  "DC virtual gateway",
+	}
+	peeringAttachmentType = attachmentType{
+		ResourceType: "peering",
+		Path:         "peering-attachments",
+		BodyKey:      "peering_attachment",
+		Name:         "peering attachment",
+	}
+)
+
+func buildAttachmentPath(client *golangsdk.ServiceClient, instanceId string, t attachmentType) string {
+	path := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/" + t.Path
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{er_id}", instanceId)
+}
+
+func getAttachment(client *golangsdk.ServiceClient, instanceId, attachmentId string,
+	t attachmentType) (interface{}, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", buildAttachmentPath(client, instanceId, t)+"/"+attachmentId, &opt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch(t.BodyKey, respBody, nil), nil
+}
+
+func updateAttachment(client *golangsdk.ServiceClient, instanceId, attachmentId string, t attachmentType,
+	params map[string]interface{}) error {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody: map[string]interface{}{
+			t.BodyKey: params,
+		},
+	}
+	_, err := client.Request("PUT", buildAttachmentPath(client, instanceId, t)+"/"+attachmentId, &opt)
+	return err
+}
+
+// listAttachments queries all the attachments of the ER instance, the query parameters are appended to the URL.
+func listAttachments(client *golangsdk.ServiceClient, instanceId, queryParams string) ([]interface{}, error) {
+	path := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/attachments?limit=100" + queryParams
+	path = strings.ReplaceAll(path, "{er_id}", instanceId)
+
+	return utils.ListWithMarker(client, path, "attachments")
+}
+
+// waitForAttachmentCreated waits for the attachment of the resource which is created by the owner service (such
+// as VPN and DC) after the resource is connected to the ER instance, and returns the attachment ID.
+func waitForAttachmentCreated(ctx context.Context, client *golangsdk.ServiceClient, instanceId, resourceId string,
+	t attachmentType, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			attachments, err := listAttachments(client, instanceId, utils.BuildQueryParams(map[string]interface{}{
+				"resource_type": t.ResourceType,
+				"resource_id":   resourceId,
+			}))
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if len(attachments) < 1 {
+				return attachments, "PENDING", nil
+			}
+
+			attachment := attachments[0]
+			status := utils.PathSearch("state", attachment, "").(string)
+			if status == "failed" {
+				return attachment, "ERROR", fmt.Errorf("unexpected status '%s'", status)
+			}
+			if status == "available" {
+				return attachment, "COMPLETED", nil
+			}
+			return attachment, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	attachment, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return utils.PathSearch("id", attachment, "").(string), nil
+}
+
+func attachmentStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId, attachmentId string,
+	t attachmentType, targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := getAttachment(client, instanceId, attachmentId, t)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && len(targets) < 1 {
+				return "deleted", "COMPLETED", nil
+			}
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] The details of the %s (%s) is: %#v", t.Name, attachmentId, attachment)
+
+		status := utils.PathSearch("state", attachment, "").(string)
+		if status == "failed" {
+			return attachment, "", fmt.Errorf("unexpected status '%s'", status)
+		}
+		if utils.StrSliceContains(targets, status) {
+			return attachment, "COMPLETED", nil
+		}
+		return attachment, "PENDING", nil
+	}
+}
+
+func waitForAttachmentStatus(ctx context.Context, client *golangsdk.ServiceClient, instanceId, attachmentId string,
+	t attachmentType, targets []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      attachmentStatusRefreshFunc(client, instanceId, attachmentId, t, targets),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceAttachmentImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<attachment_id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
+
+// resourceServiceAttachment builds the resource of the attachment which is created by the owner service (such as VPN
+// and DC) after the attached resource is connected to the ER instance. The resource waits for the attachment and
+// manages its basic information, and the attachment is deleted with the attached resource.
+func resourceServiceAttachment(t attachmentType) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceServiceAttachmentCreate(ctx, d, meta, t)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceServiceAttachmentUpdate(ctx, d, meta, t)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceServiceAttachmentRead(ctx, d, meta, t)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceServiceAttachmentDelete(ctx, d, meta, t)
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The region where the ER instance and the %s are located.", t.Name),
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The ID of the ER instance to which the %s belongs.", t.Name),
+			},
+			t.ResourceIdKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The ID of the %s to which the %s belongs.", t.ResourceName, t.Name),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile("^[\u4e00-\u9fa5\\w.-]*$"), "The name only english and "+
+						"chinese letters, digits, underscore (_), hyphens (-) and dots (.) are allowed."),
+				),
+				Description: fmt.Sprintf("The name of the %s.", t.Name),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"The angle brackets (< and >) are not allowed."),
+				),
+				Description: fmt.Sprintf("The description of the %s.", t.Name),
+			},
+			// Attributes
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The current status of the %s.", t.Name),
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time.`,
+			},
+		},
+	}
+}
+
+func resourceServiceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{},
+	t attachmentType) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	resourceId := d.Get(t.ResourceIdKey).(string)
+	attachmentId, err := waitForAttachmentCreated(ctx, client, instanceId, resourceId, t,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the %s of the %s (%s) to become available: %s", t.Name,
+			t.ResourceName, resourceId, err)
+	}
+	d.SetId(attachmentId)
+
+	if d.HasChanges("name", "description") {
+		if err = updateServiceAttachmentBasicInfo(ctx, d, meta, t, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceServiceAttachmentRead(ctx, d, meta, t)
+}
+
+func resourceServiceAttachmentRead(_ context.Context, d *schema.ResourceData, meta interface{},
+	t attachmentType) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ErV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	attachment, err := getAttachment(client, d.Get("instance_id").(string), d.Id(), t)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER "+t.Name)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set(t.ResourceIdKey, utils.PathSearch("resource_id", attachment, nil)),
+		d.Set("name", utils.PathSearch("name", attachment, nil)),
+		d.Set("description", utils.PathSearch("description", attachment, nil)),
+		d.Set("status", utils.PathSearch("state", attachment, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", attachment, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", attachment, nil)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving %s (%s) fields: %s", t.Name, d.Id(), mErr)
+	}
+	return nil
+}
+
+func updateServiceAttachmentBasicInfo(ctx context.Context, d *schema.ResourceData, meta interface{},
+	t attachmentType, timeout time.Duration) error {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return err
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	params := map[string]interface{}{
+		"name":        utils.ValueIngoreEmpty(d.Get("name")),
+		"description": d.Get("description"),
+	}
+	if err = updateAttachment(client, instanceId, d.Id(), t, utils.RemoveNil(params)); err != nil {
+		return err
+	}
+	return waitForAttachmentStatus(ctx, client, instanceId, d.Id(), t, []string{"available"}, timeout)
+}
+
+func resourceServiceAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{},
+	t attachmentType) diag.Diagnostics {
+	if d.HasChanges("name", "description") {
+		if err := updateServiceAttachmentBasicInfo(ctx, d, meta, t, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error updating %s (%s): %s", t.Name, d.Id(), err)
+		}
+	}
+	return resourceServiceAttachmentRead(ctx, d, meta, t)
+}
+
+func resourceServiceAttachmentDelete(_ context.Context, d *schema.ResourceData, _ interface{},
+	t attachmentType) diag.Diagnostics {
+	// The attachment is deleted by the owner service when the attached resource is deleted.
+	log.Printf("[WARN] the %s (%s) is only removed from the state, it will be deleted with the %s", t.Name, d.Id(),
+		t.ResourceName)
+	return nil
+}
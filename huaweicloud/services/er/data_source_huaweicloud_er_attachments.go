@@ -0,0 +1,198 @@
+package er
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the ER instance and the attachments are located.`,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the ER instance to which the attachments belong.`,
+			},
+			"attachment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The attachment ID used to query specified attachment.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The name used to filter the attachments.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The resource type used to filter the attachments.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "vpn", "vgw", "peering",
+				}, false),
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The ID of the attached resource used to filter the attachments.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The status used to filter the attachments.`,
+			},
+			"tags": common.TagsSchema(),
+			// Attributes
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The attachment ID.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the attachment.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the attachment.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the attached resource.`,
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the attached resource.`,
+						},
+						"associated": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether the attachment is associated with a route table.`,
+						},
+						"route_table_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the route table associated with the attachment.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The current status of the attachment.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The creation time.`,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The latest update time.`,
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The tags configuration of the attachment.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildAttachmentsQueryParams(d *schema.ResourceData) string {
+	return utils.BuildQueryParams(map[string]interface{}{
+		"id":            d.Get("attachment_id"),
+		"resource_type": d.Get("type"),
+		"resource_id":   d.Get("resource_id"),
+		"state":         d.Get("status"),
+	})
+}
+
+func flattenAttachments(d *schema.ResourceData, all []interface{}) []map[string]interface{} {
+	name := d.Get("name").(string)
+	tagFilter := d.Get("tags").(map[string]interface{})
+
+	result := make([]map[string]interface{}, 0, len(all))
+	for _, attachment := range all {
+		if name != "" && utils.PathSearch("name", attachment, "").(string) != name {
+			continue
+		}
+		tags := utils.FlattenTagsToMap(utils.PathSearch("tags", attachment, nil))
+		if !isTagsMatched(tags, tagFilter) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":             utils.PathSearch("id", attachment, nil),
+			"name":           utils.PathSearch("name", attachment, nil),
+			"description":    utils.PathSearch("description", attachment, nil),
+			"type":           utils.PathSearch("resource_type", attachment, nil),
+			"resource_id":    utils.PathSearch("resource_id", attachment, nil),
+			"associated":     utils.PathSearch("associated", attachment, nil),
+			"route_table_id": utils.PathSearch("route_table_id", attachment, nil),
+			"status":         utils.PathSearch("state", attachment, nil),
+			"created_at":     utils.PathSearch("created_at", attachment, nil),
+			"updated_at":     utils.PathSearch("updated_at", attachment, nil),
+			"tags":           tags,
+		})
+	}
+	return result
+}
+
+func dataSourceAttachmentsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ErV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	attachments, err := listAttachments(client, d.Get("instance_id").(string), buildAttachmentsQueryParams(d))
+	if err != nil {
+		return diag.Errorf("error retrieving ER attachments: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("attachments", flattenAttachments(d, attachments)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving ER attachment list field: %s", mErr)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package er
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the ER instances are located.`,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The ER instance ID used to query specified instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The name used to filter the instances.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The status used to filter the instances.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The enterprise project ID used to filter the instances.`,
+			},
+			"tags": common.TagsSchema(),
+			// Attributes
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ER instance ID.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the ER instance.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the ER instance.`,
+						},
+						"asn": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The BGP AS number of the ER instance.`,
+						},
+						"availability_zones": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The availability zone list where the ER instance is located.`,
+						},
+						"enterprise_project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The enterprise project ID to which the ER instance belongs.`,
+						},
+						"enable_default_propagation": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether to enable the propagation of the default route table.`,
+						},
+						"enable_default_association": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether to enable the association of the default route table.`,
+						},
+						"auto_accept_shared_attachments": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether to automatically accept the creation of shared attachment.`,
+						},
+						"default_propagation_route_table_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the default propagation route table.`,
+						},
+						"default_association_route_table_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the default association route table.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The current status of the ER instance.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The creation time.`,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The latest update time.`,
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The tags configuration of the ER instance.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildInstancesQueryParams(d *schema.ResourceData) string {
+	return utils.BuildQueryParams(map[string]interface{}{
+		"id":                    d.Get("instance_id"),
+		"state":                 d.Get("status"),
+		"enterprise_project_id": d.Get("enterprise_project_id"),
+	})
+}
+
+// isTagsMatched returns whether the tags flattened from the API response contain all the filter tags.
+func isTagsMatched(tags, tagFilter map[string]interface{}) bool {
+	tagMap := make(map[string]string, len(tags))
+	for k, v := range tags {
+		tagMap[k] = fmt.Sprint(v)
+	}
+	return utils.HasMapContains(tagMap, tagFilter)
+}
+
+func flattenInstances(d *schema.ResourceData, all []interface{}) []map[string]interface{} {
+	name := d.Get("name").(string)
+	tagFilter := d.Get("tags").(map[string]interface{})
+
+	result := make([]map[string]interface{}, 0, len(all))
+	for _, instance := range all {
+		if name != "" && utils.PathSearch("name", instance, "").(string) != name {
+			continue
+		}
+		tags := utils.FlattenTagsToMap(utils.PathSearch("tags", instance, nil))
+		if !isTagsMatched(tags, tagFilter) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                                 utils.PathSearch("id", instance, nil),
+			"name":                               utils.PathSearch("name", instance, nil),
+			"description":                        utils.PathSearch("description", instance, nil),
+			"asn":                                utils.PathSearch("asn", instance, nil),
+			"availability_zones":                 utils.PathSearch("availability_zone_ids", instance, nil),
+			"enterprise_project_id":              utils.PathSearch("enterprise_project_id", instance, nil),
+			"enable_default_propagation":         utils.PathSearch("enable_default_propagation", instance, nil),
+			"enable_default_association":         utils.PathSearch("enable_default_association", instance, nil),
+			"auto_accept_shared_attachments":     utils.PathSearch("auto_accept_shared_attachments", instance, nil),
+			"default_propagation_route_table_id": utils.PathSearch("default_propagation_route_table_id", instance, nil),
+			"default_association_route_table_id": utils.PathSearch("default_association_route_table_id", instance, nil),
+			"status":                             utils.PathSearch("state", instance, nil),
+			"created_at":                         utils.PathSearch("created_at", instance, nil),
+			"updated_at":                         utils.PathSearch("updated_at", instance, nil),
+			"tags":                               tags,
+		})
+	}
+	return result
+}
+
+func dataSourceInstancesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ErV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	path := client.Endpoint + "v3/{project_id}/enterprise-router/instances?limit=100" + buildInstancesQueryParams(d)
+	instances, err := utils.ListWithMarker(client, path, "instances")
+	if err != nil {
+		return diag.Errorf("error retrieving ER instances: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instances", flattenInstances(d, instances)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving ER instance list field: %s", mErr)
+	}
+	return nil
+}
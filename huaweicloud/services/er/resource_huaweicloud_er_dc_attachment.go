@@ -0,0 +1,11 @@
+package er
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDcAttachment manages the DC attachment, which is created by the DC service after the DC virtual gateway is
+// connected to the ER instance.
+func ResourceDcAttachment() *schema.Resource {
+	return resourceServiceAttachment(dcAttachmentType)
+}
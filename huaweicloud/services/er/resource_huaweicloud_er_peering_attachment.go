@@ -0,0 +1,247 @@
+package er
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePeeringAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePeeringAttachmentCreate,
+		UpdateContext: resourcePeeringAttachmentUpdate,
+		ReadContext:   resourcePeeringAttachmentRead,
+		DeleteContext: resourcePeeringAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the ER instance and the peering attachment are located.`,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the ER instance to which the peering attachment belongs.`,
+			},
+			"peer_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the peer ER instance.`,
+			},
+			"peer_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The region where the peer ER instance is located.`,
+			},
+			"peer_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The project ID of the peer ER instance.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile("^[\u4e00-\u9fa5\\w.-]*$"), "The name only english and "+
+						"chinese letters, digits, underscore (_), hyphens (-) and dots (.) are allowed."),
+				),
+				Description: `The name of the peering attachment.`,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"The angle brackets (< and >) are not allowed."),
+				),
+				Description: `The description of the peering attachment.`,
+			},
+			"tags": common.TagsForceNewSchema(),
+			// Attributes
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the peering attachment.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time.`,
+			},
+		},
+	}
+}
+
+func buildPeeringAttachmentCreateBodyParams(d *schema.ResourceData, projectId string) map[string]interface{} {
+	peerProjectId := d.Get("peer_project_id").(string)
+	if peerProjectId == "" {
+		peerProjectId = projectId
+	}
+
+	return map[string]interface{}{
+		peeringAttachmentType.BodyKey: utils.RemoveNil(map[string]interface{}{
+			"name":        d.Get("name"),
+			"description": utils.ValueIngoreEmpty(d.Get("description")),
+			"peering_attachment": map[string]interface{}{
+				"peer_router_id":  d.Get("peer_instance_id"),
+				"peer_project_id": peerProjectId,
+				"peer_region_id":  d.Get("peer_region"),
+			},
+			"tags": utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+		}),
+	}
+}
+
+func resourcePeeringAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody:         buildPeeringAttachmentCreateBodyParams(d, client.ProjectID),
+	}
+	resp, err := client.Request("POST", buildAttachmentPath(client, instanceId, peeringAttachmentType), &opt)
+	if err != nil {
+		return diag.Errorf("error creating peering attachment: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	attachmentId := utils.PathSearch("peering_attachment.id", respBody, "").(string)
+	if attachmentId == "" {
+		return diag.Errorf("unable to find the peering attachment ID from the API response")
+	}
+	d.SetId(attachmentId)
+
+	// the attachment turns to available after the peer ER instance accepts it, the acceptance is automatic when
+	// the peer instance belongs to the same account
+	err = waitForAttachmentStatus(ctx, client, instanceId, d.Id(), peeringAttachmentType,
+		[]string{"available", "pending_acceptance"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the peering attachment (%s) to become available: %s", d.Id(), err)
+	}
+	return resourcePeeringAttachmentRead(ctx, d, meta)
+}
+
+func resourcePeeringAttachmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ErV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	attachment, err := getAttachment(client, d.Get("instance_id").(string), d.Id(), peeringAttachmentType)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER peering attachment")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("peer_instance_id", utils.PathSearch("peering_attachment.peer_router_id", attachment, nil)),
+		d.Set("peer_region", utils.PathSearch("peering_attachment.peer_region_id", attachment, nil)),
+		d.Set("peer_project_id", utils.PathSearch("peering_attachment.peer_project_id", attachment, nil)),
+		d.Set("name", utils.PathSearch("name", attachment, nil)),
+		d.Set("description", utils.PathSearch("description", attachment, nil)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("tags", attachment, nil))),
+		d.Set("status", utils.PathSearch("state", attachment, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", attachment, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", attachment, nil)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving peering attachment (%s) fields: %s", d.Id(), mErr)
+	}
+	return nil
+}
+
+func resourcePeeringAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	if d.HasChanges("name", "description") {
+		params := map[string]interface{}{
+			"name":        d.Get("name"),
+			"description": d.Get("description"),
+		}
+		if err = updateAttachment(client, instanceId, d.Id(), peeringAttachmentType, params); err != nil {
+			return diag.Errorf("error updating peering attachment (%s): %s", d.Id(), err)
+		}
+		err = waitForAttachmentStatus(ctx, client, instanceId, d.Id(), peeringAttachmentType,
+			[]string{"available", "pending_acceptance"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for the peering attachment (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+	return resourcePeeringAttachmentRead(ctx, d, meta)
+}
+
+func resourcePeeringAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202, 204},
+	}
+	_, err = client.Request("DELETE", buildAttachmentPath(client, instanceId, peeringAttachmentType)+"/"+d.Id(), &opt)
+	if err != nil {
+		return diag.Errorf("error deleting peering attachment (%s) form the ER instance: %s", d.Id(), err)
+	}
+
+	err = waitForAttachmentStatus(ctx, client, instanceId, d.Id(), peeringAttachmentType, nil,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the peering attachment (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The update and delete methods of the static route in the SDK are broken (the route ID and the route table ID of the
+// URL are swapped), so the static route is managed through the raw requests.
+const staticRouteHttpUrl = "v3/{project_id}/enterprise-router/route-tables/{route_table_id}/static-routes"
+
+func ResourceStaticRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStaticRouteCreate,
+		UpdateContext: resourceStaticRouteUpdate,
+		ReadContext:   resourceStaticRouteRead,
+		DeleteContext: resourceStaticRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStaticRouteImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the ER route table and the static route are located.`,
+			},
+			"route_table_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the route table to which the static route belongs.`,
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The destination address (CIDR) of the static route.`,
+			},
+			"attachment_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"is_blackhole"},
+				Description:   `The ID of the attachment to which the traffic is forwarded.`,
+			},
+			"is_blackhole": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Whether the static route is a black hole route.`,
+			},
+			// Attributes
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the static route.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the static route.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time.`,
+			},
+		},
+	}
+}
+
+func buildStaticRoutePath(client *golangsdk.ServiceClient, routeTableId string) string {
+	path := client.Endpoint + staticRouteHttpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{route_table_id}", routeTableId)
+}
+
+func buildStaticRouteBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"attachment_id": utils.ValueIngoreEmpty(d.Get("attachment_id")),
+		"is_blackhole":  d.Get("is_blackhole"),
+	}
+}
+
+func getStaticRoute(client *golangsdk.ServiceClient, routeTableId, routeId string) (interface{}, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", buildStaticRoutePath(client, routeTableId)+"/"+routeId, &opt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("route", respBody, nil), nil
+}
+
+func staticRouteStatusRefreshFunc(client *golangsdk.ServiceClient, routeTableId, routeId string,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		route, err := getStaticRoute(client, routeTableId, routeId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && len(targets) < 1 {
+				return "deleted", "COMPLETED", nil
+			}
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] The details of the static route (%s) is: %#v", routeId, route)
+
+		status := utils.PathSearch("state", route, "").(string)
+		if status == "failed" {
+			return route, "", fmt.Errorf("unexpected status '%s'", status)
+		}
+		if utils.StrSliceContains(targets, status) {
+			return route, "COMPLETED", nil
+		}
+		return route, "PENDING", nil
+	}
+}
+
+func waitForStaticRouteStatus(ctx context.Context, client *golangsdk.ServiceClient, routeTableId, routeId string,
+	targets []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      staticRouteStatusRefreshFunc(client, routeTableId, routeId, targets),
+		Timeout:      timeout,
+		Delay:        2 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceStaticRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	bodyParams := buildStaticRouteBodyParams(d)
+	bodyParams["destination"] = d.Get("destination")
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 202},
+		JSONBody: map[string]interface{}{
+			"route": utils.RemoveNil(bodyParams),
+		},
+	}
+	resp, err := client.Request("POST", buildStaticRoutePath(client, routeTableId), &opt)
+	if err != nil {
+		return diag.Errorf("error creating static route: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	routeId := utils.PathSearch("route.id", respBody, "").(string)
+	if routeId == "" {
+		return diag.Errorf("unable to find the static route ID from the API response")
+	}
+	d.SetId(routeId)
+
+	err = waitForStaticRouteStatus(ctx, client, routeTableId, d.Id(), []string{"available"},
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the static route (%s) to become available: %s", d.Id(), err)
+	}
+	return resourceStaticRouteRead(ctx, d, meta)
+}
+
+func resourceStaticRouteRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ErV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	route, err := getStaticRoute(client, d.Get("route_table_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER static route")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("destination", utils.PathSearch("destination", route, nil)),
+		d.Set("attachment_id", utils.PathSearch("attachments[0].attachment_id", route, nil)),
+		d.Set("is_blackhole", utils.PathSearch("is_blackhole", route, false)),
+		d.Set("type", utils.PathSearch("type", route, nil)),
+		d.Set("status", utils.PathSearch("state", route, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", route, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", route, nil)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving static route (%s) fields: %s", d.Id(), mErr)
+	}
+	return nil
+}
+
+func resourceStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody: map[string]interface{}{
+			"route": utils.RemoveNil(buildStaticRouteBodyParams(d)),
+		},
+	}
+	_, err = client.Request("PUT", buildStaticRoutePath(client, routeTableId)+"/"+d.Id(), &opt)
+	if err != nil {
+		return diag.Errorf("error updating static route (%s): %s", d.Id(), err)
+	}
+
+	err = waitForStaticRouteStatus(ctx, client, routeTableId, d.Id(), []string{"available"},
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("error waiting for the static route (%s) to be updated: %s", d.Id(), err)
+	}
+	return resourceStaticRouteRead(ctx, d, meta)
+}
+
+func resourceStaticRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202, 204},
+	}
+	_, err = client.Request("DELETE", buildStaticRoutePath(client, routeTableId)+"/"+d.Id(), &opt)
+	if err != nil {
+		return diag.Errorf("error deleting static route (%s): %s", d.Id(), err)
+	}
+
+	err = waitForStaticRouteStatus(ctx, client, routeTableId, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the static route (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceStaticRouteImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<route_table_id>/<static_route_id>', but '%s'",
+			d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("route_table_id", parts[0])
+}
@@ -0,0 +1,11 @@
+package er
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceVpnAttachment manages the VPN attachment, which is created by the VPN service after the VPN connection is
+// connected to the ER instance.
+func ResourceVpnAttachment() *schema.Resource {
+	return resourceServiceAttachment(vpnAttachmentType)
+}
@@ -219,6 +219,12 @@ func ResourceFgsFunctionV2() *schema.Resource {
 					"code_type",
 				},
 			},
+			"max_instance_num": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -325,6 +331,12 @@ func resourceFgsFunctionV2Create(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 	}
+	if _, ok := d.GetOk("max_instance_num"); ok {
+		err := resourceFgsFunctionMaxInstanceNumUpdate(fgsClient, urn, d)
+		if err != nil {
+			return err
+		}
+	}
 
 	return resourceFgsFunctionV2Read(d, meta)
 }
@@ -425,6 +437,7 @@ func resourceFgsFunctionV2Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enterprise_project_id", f.EnterpriseProjectID),
 		d.Set("functiongraph_version", f.Type),
 		d.Set("custom_image", flattenFgsCustomImage(f.CustomImage)),
+		setFgsFunctionMaxInstanceNum(d, f.StrategyConfig),
 		setFgsFunctionApp(d, f.Package),
 		setFgsFunctionAgency(d, f.Xrole),
 		setFgsFunctionVpcAccess(d, f.FuncVpc),
@@ -462,6 +475,12 @@ func resourceFgsFunctionV2Update(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 	}
+	if d.HasChange("max_instance_num") {
+		err := resourceFgsFunctionMaxInstanceNumUpdate(fgsClient, urn, d)
+		if err != nil {
+			return err
+		}
+	}
 
 	return resourceFgsFunctionV2Read(d, meta)
 }
@@ -570,6 +589,30 @@ func resourceFgsFunctionV2CodeUpdate(fgsClient *golangsdk.ServiceClient, urn str
 	return nil
 }
 
+func resourceFgsFunctionMaxInstanceNumUpdate(fgsClient *golangsdk.ServiceClient, urn string,
+	d *schema.ResourceData) error {
+	opts := map[string]interface{}{
+		"max_instance_num": d.Get("max_instance_num").(int),
+	}
+
+	logp.Printf("[DEBUG] Max Instance Number Update Options: %#v", opts)
+	url := fgsClient.ServiceURL("fgs", "functions", urn, "config-max-instance")
+	_, err := fgsClient.Put(url, opts, nil, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	if err != nil {
+		return fmtp.Errorf("Error updating max instance number of HuaweiCloud function: %s", err)
+	}
+
+	return nil
+}
+
+func setFgsFunctionMaxInstanceNum(d *schema.ResourceData, strategyConfig function.StrategyConfig) error {
+	// The concurrency of the strategy configuration is the maximum number of instances
+	if strategyConfig.Concurrency != nil {
+		return d.Set("max_instance_num", *strategyConfig.Concurrency)
+	}
+	return nil
+}
+
 func resourceFgsFunctionFuncVpc(d *schema.ResourceData) *function.FuncVpc {
 	var funcVpc function.FuncVpc
 	funcVpc.VpcId = d.Get("vpc_id").(string)
@@ -0,0 +1,211 @@
+package fgs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The SDK does not support the description and the additional version weights of the alias, so the alias is managed
+// through the raw requests.
+const functionAliasHttpUrl = "v2/{project_id}/fgs/functions/{function_urn}/aliases"
+
+func ResourceFunctionAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFunctionAliasCreate,
+		ReadContext:   resourceFunctionAliasRead,
+		UpdateContext: resourceFunctionAliasUpdate,
+		DeleteContext: resourceFunctionAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFunctionAliasImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"function_urn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URN of the function to which the alias belongs.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the alias.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the version to which the alias points.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the alias.",
+			},
+			"additional_version_weights": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				Description: "The percentages of the traffic routed to the additional versions.",
+			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URN of the alias.",
+			},
+		},
+	}
+}
+
+func buildFunctionAliasPath(client *golangsdk.ServiceClient, functionUrn string) string {
+	path := client.Endpoint + functionAliasHttpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{function_urn}", functionUrn)
+}
+
+func buildFunctionAliasBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"version":                    d.Get("version"),
+		"description":                d.Get("description"),
+		"additional_version_weights": d.Get("additional_version_weights"),
+	}
+}
+
+func resourceFunctionAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	functionUrn := d.Get("function_urn").(string)
+	aliasName := d.Get("name").(string)
+	bodyParams := buildFunctionAliasBodyParams(d)
+	bodyParams["name"] = aliasName
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody:         bodyParams,
+	}
+	_, err = client.Request("POST", buildFunctionAliasPath(client, functionUrn), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating alias (%s) of function (%s): %s", aliasName, functionUrn, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", functionUrn, aliasName))
+
+	return resourceFunctionAliasRead(ctx, d, meta)
+}
+
+func resourceFunctionAliasRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.FgsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	functionUrn := d.Get("function_urn").(string)
+	aliasName := d.Get("name").(string)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", buildFunctionAliasPath(client, functionUrn)+"/"+aliasName, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "function alias")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("version", utils.PathSearch("version", respBody, nil)),
+		d.Set("description", utils.PathSearch("description", respBody, nil)),
+		d.Set("additional_version_weights", utils.PathSearch("additional_version_weights", respBody, nil)),
+		d.Set("urn", utils.PathSearch("alias_urn", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving function alias fields: %s", err)
+	}
+	return nil
+}
+
+func resourceFunctionAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	functionUrn := d.Get("function_urn").(string)
+	aliasName := d.Get("name").(string)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody:         buildFunctionAliasBodyParams(d),
+	}
+	_, err = client.Request("PUT", buildFunctionAliasPath(client, functionUrn)+"/"+aliasName, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating alias (%s) of function (%s): %s", aliasName, functionUrn, err)
+	}
+
+	return resourceFunctionAliasRead(ctx, d, meta)
+}
+
+func resourceFunctionAliasDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	functionUrn := d.Get("function_urn").(string)
+	aliasName := d.Get("name").(string)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	_, err = client.Request("DELETE", buildFunctionAliasPath(client, functionUrn)+"/"+aliasName, &deleteOpt)
+	if err != nil {
+		return diag.Errorf("error deleting alias (%s) of function (%s): %s", aliasName, functionUrn, err)
+	}
+	return nil
+}
+
+func resourceFunctionAliasImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	index := strings.LastIndex(d.Id(), "/")
+	if index == -1 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <function_urn>/<name>")
+	}
+
+	mErr := multierror.Append(
+		d.Set("function_urn", d.Id()[:index]),
+		d.Set("name", d.Id()[index+1:]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,202 @@
+package fgs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceFunctionReservedInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFunctionReservedInstanceCreate,
+		ReadContext:   resourceFunctionReservedInstanceRead,
+		UpdateContext: resourceFunctionReservedInstanceUpdate,
+		DeleteContext: resourceFunctionReservedInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFunctionReservedInstanceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"function_urn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URN of the function to which the reserved instances belong.",
+			},
+			"qualifier_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"version", "alias"}, false),
+				Description:  "The type of the qualifier.",
+			},
+			"qualifier_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the version or the alias.",
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "The number of the reserved instances.",
+			},
+			"idle_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to enable the idle mode of the reserved instances.",
+			},
+		},
+	}
+}
+
+func updateFunctionReservedInstances(client *golangsdk.ServiceClient, qualifiedUrn string, count int,
+	idleMode bool) error {
+	path := client.Endpoint + "v2/{project_id}/fgs/functions/{function_urn}/reservedinstances"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{function_urn}", qualifiedUrn)
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"count":     count,
+			"idle_mode": idleMode,
+		},
+	}
+	_, err := client.Request("PUT", path, &opt)
+	return err
+}
+
+func resourceFunctionReservedInstanceCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	qualifiedUrn := fmt.Sprintf("%s:%s", d.Get("function_urn").(string), d.Get("qualifier_name").(string))
+	err = updateFunctionReservedInstances(client, qualifiedUrn, d.Get("instance_count").(int),
+		d.Get("idle_mode").(bool))
+	if err != nil {
+		return diag.Errorf("error configuring reserved instances of function (%s): %s", qualifiedUrn, err)
+	}
+	d.SetId(qualifiedUrn)
+
+	return resourceFunctionReservedInstanceRead(ctx, d, meta)
+}
+
+func getFunctionReservedInstanceConfig(client *golangsdk.ServiceClient, functionUrn,
+	qualifierName string) (interface{}, error) {
+	path := client.Endpoint + "v2/{project_id}/fgs/functions/reservedinstanceconfigs?function_urn={function_urn}" +
+		"&limit=100"
+	path = strings.ReplaceAll(path, "{function_urn}", functionUrn)
+
+	configs, err := utils.ListWithMarker(client, path, "reserved_instances")
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range configs {
+		if utils.PathSearch("qualifier_name", v, "") == qualifierName &&
+			utils.PathSearch("min_count", v, float64(0)).(float64) > 0 {
+			return v, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceFunctionReservedInstanceRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.FgsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	functionUrn := d.Get("function_urn").(string)
+	qualifierName := d.Get("qualifier_name").(string)
+	reservedConfig, err := getFunctionReservedInstanceConfig(client, functionUrn, qualifierName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "function reserved instances")
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("qualifier_type", utils.PathSearch("qualifier_type", reservedConfig, nil)),
+		d.Set("instance_count", utils.PathSearch("min_count", reservedConfig, nil)),
+		d.Set("idle_mode", utils.PathSearch("idle_mode", reservedConfig, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving function reserved instance fields: %s", err)
+	}
+	return nil
+}
+
+func resourceFunctionReservedInstanceUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	err = updateFunctionReservedInstances(client, d.Id(), d.Get("instance_count").(int),
+		d.Get("idle_mode").(bool))
+	if err != nil {
+		return diag.Errorf("error updating reserved instances of function (%s): %s", d.Id(), err)
+	}
+
+	return resourceFunctionReservedInstanceRead(ctx, d, meta)
+}
+
+func resourceFunctionReservedInstanceDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	// the reserved instances are released by setting the count to zero
+	err = updateFunctionReservedInstances(client, d.Id(), 0, false)
+	if err != nil {
+		return diag.Errorf("error releasing reserved instances of function (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceFunctionReservedInstanceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	index := strings.LastIndex(d.Id(), ":")
+	if index == -1 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <function_urn>:<qualifier_name>")
+	}
+
+	mErr := multierror.Append(
+		d.Set("function_urn", d.Id()[:index]),
+		d.Set("qualifier_name", d.Id()[index+1:]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,130 @@
+package fgs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/fgs/v2/function"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceFunctionVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFunctionVersionCreate,
+		ReadContext:   resourceFunctionVersionRead,
+		DeleteContext: resourceFunctionVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"function_urn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URN of the function to which the version belongs.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the version.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the version.",
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The SHA-512 digest of the function code to be published.",
+			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URN of the version.",
+			},
+		},
+	}
+}
+
+func resourceFunctionVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	functionUrn := d.Get("function_urn").(string)
+	opts := function.CreateVersionOpts{
+		Version:     d.Get("version").(string),
+		Description: d.Get("description").(string),
+		Digest:      d.Get("digest").(string),
+	}
+	resp, err := function.CreateVersion(client, opts, functionUrn).Extract()
+	if err != nil {
+		return diag.Errorf("error publishing version of function (%s): %s", functionUrn, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", functionUrn, resp.Version))
+
+	return resourceFunctionVersionRead(ctx, d, meta)
+}
+
+func resourceFunctionVersionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.FgsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	resp, err := function.GetMetadata(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "function version")
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", region),
+		d.Set("function_urn", resourceFgsFunctionUrn(d.Id())),
+		d.Set("version", resp.Version),
+		d.Set("description", resp.VersionDescription),
+		d.Set("digest", resp.Digest),
+		d.Set("urn", d.Id()),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving function version fields: %s", err)
+	}
+	return nil
+}
+
+func resourceFunctionVersionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph V2 client: %s", err)
+	}
+
+	err = function.Delete(client, d.Id()).ExtractErr()
+	if err != nil {
+		return diag.Errorf("error deleting function version (%s): %s", d.Id(), err)
+	}
+	return nil
+}
@@ -70,6 +70,11 @@ func ResourceFunctionGraphTrigger() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"function_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -595,7 +600,7 @@ func resourceFunctionGraphTriggerCreate(d *schema.ResourceData, meta interface{}
 		return fmtp.Errorf("Error building create options of FunctionGraph: %s", err)
 	}
 	logp.Printf("[DEBUG] The create options is: %#v", opts)
-	urn := d.Get("function_urn").(string)
+	urn := buildTriggerFunctionUrn(d)
 	resp, err := trigger.Create(client, opts, urn).Extract()
 	if err != nil {
 		return fmtp.Errorf("Error creating HuaweiCloud FunctionGraph trigger for function (%s): %s", urn, err)
@@ -778,7 +783,7 @@ func resourceFunctionGraphTriggerRead(d *schema.ResourceData, meta interface{})
 		return fmtp.Errorf("Error creating HuaweiCloud FunctionGraph v2 client: %s", err)
 	}
 
-	urn := d.Get("function_urn").(string)
+	urn := buildTriggerFunctionUrn(d)
 	pages, err := trigger.List(client, urn).AllPages()
 	if err != nil {
 		return common.CheckDeleted(d, parseRequestError(err), "error retrieving FunctionGraph trigger")
@@ -814,7 +819,7 @@ func resourceFunctionGraphTriggerUpdate(d *schema.ResourceData, meta interface{}
 		return fmtp.Errorf("Error creating HuaweiCloud FunctionGraph v2 client: %s", err)
 	}
 
-	urn := d.Get("function_urn").(string)
+	urn := buildTriggerFunctionUrn(d)
 	triggerType := d.Get("type").(string)
 	targetStatus := d.Get("status").(string)
 	opts := trigger.UpdateOpts{
@@ -873,7 +878,7 @@ func resourceFunctionGraphTriggerDelete(d *schema.ResourceData, meta interface{}
 		return fmtp.Errorf("Error creating HuaweiCloud FunctionGraph v2 client: %s", err)
 	}
 
-	urn := d.Get("function_urn").(string)
+	urn := buildTriggerFunctionUrn(d)
 	triggerType := d.Get("type").(string)
 	err = trigger.Delete(client, urn, triggerType, d.Id()).ExtractErr()
 	if err != nil {
@@ -884,6 +889,15 @@ func resourceFunctionGraphTriggerDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// buildTriggerFunctionUrn returns the URN of the function alias if the trigger is bound to an alias.
+func buildTriggerFunctionUrn(d *schema.ResourceData) string {
+	urn := d.Get("function_urn").(string)
+	if alias, ok := d.GetOk("function_alias"); ok {
+		return fmt.Sprintf("%s:%s", urn, alias)
+	}
+	return urn
+}
+
 func parseRequestError(respErr error) error {
 	var apiErr trigger.Error
 	if errCode, ok := respErr.(golangsdk.ErrDefault500); ok && errCode.Body != nil {
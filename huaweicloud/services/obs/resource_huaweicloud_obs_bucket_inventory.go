@@ -0,0 +1,223 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+type inventoryConfiguration struct {
+	XMLName                xml.Name             `xml:"InventoryConfiguration"`
+	ID                     string               `xml:"Id"`
+	IsEnabled              bool                 `xml:"IsEnabled"`
+	Filter                 *inventoryFilter     `xml:"Filter,omitempty"`
+	Destination            inventoryDestination `xml:"Destination"`
+	Frequency              string               `xml:"Schedule>Frequency"`
+	IncludedObjectVersions string               `xml:"IncludedObjectVersions"`
+	OptionalFields         []string             `xml:"OptionalFields>Field,omitempty"`
+}
+
+type inventoryFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type inventoryDestination struct {
+	Format string `xml:"Format"`
+	Bucket string `xml:"Bucket"`
+	Prefix string `xml:"Prefix,omitempty"`
+}
+
+func ResourceObsBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketInventoryPut,
+		UpdateContext: resourceObsBucketInventoryPut,
+		ReadContext:   resourceObsBucketInventoryRead,
+		DeleteContext: resourceObsBucketInventoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"configuration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Daily", "Weekly"}, false),
+			},
+			"included_object_versions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Current",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Current"}, false),
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Size", "LastModifiedDate", "ETag", "StorageClass", "IsMultipartUploaded",
+						"ReplicationStatus", "EncryptionStatus",
+					}, false),
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func buildInventoryConfiguration(d *schema.ResourceData) inventoryConfiguration {
+	configuration := inventoryConfiguration{
+		ID:        d.Get("configuration_id").(string),
+		IsEnabled: d.Get("enabled").(bool),
+		Destination: inventoryDestination{
+			// only CSV format is supported
+			Format: "CSV",
+			Bucket: d.Get("destination_bucket").(string),
+			Prefix: d.Get("destination_prefix").(string),
+		},
+		Frequency:              d.Get("frequency").(string),
+		IncludedObjectVersions: d.Get("included_object_versions").(string),
+		OptionalFields:         utils.ExpandToStringListBySet(d.Get("optional_fields").(*schema.Set)),
+	}
+	if prefix := d.Get("filter_prefix").(string); prefix != "" {
+		configuration.Filter = &inventoryFilter{Prefix: prefix}
+	}
+	return configuration
+}
+
+func resourceObsBucketInventoryPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	bucket := d.Get("bucket").(string)
+	configuration := buildInventoryConfiguration(d)
+
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = cfg.ObjectStorageBucketRequest(region, "PUT", bucket,
+		map[string]string{"inventory": "", "id": configuration.ID}, "application/xml", body)
+	if err != nil {
+		return diag.FromErr(getObsError("Error setting inventory configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, configuration.ID))
+	return resourceObsBucketInventoryRead(ctx, d, meta)
+}
+
+func parseInventoryID(id string) (bucket, configurationID string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid id format, must be <bucket>/<configuration_id>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceObsBucketInventoryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	bucket, configurationID, err := parseInventoryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := cfg.ObjectStorageBucketRequest(region, "GET", bucket,
+		map[string]string{"inventory": "", "id": configurationID}, "", nil)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] the inventory configuration (%s) of OBS bucket (%s) not found", configurationID, bucket)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error retrieving inventory configuration of OBS bucket", bucket, err))
+	}
+
+	var configuration inventoryConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return diag.Errorf("error parsing inventory configuration of OBS bucket %s: %s", bucket, err)
+	}
+
+	var filterPrefix string
+	if configuration.Filter != nil {
+		filterPrefix = configuration.Filter.Prefix
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", bucket),
+		d.Set("configuration_id", configuration.ID),
+		d.Set("destination_bucket", configuration.Destination.Bucket),
+		d.Set("destination_prefix", configuration.Destination.Prefix),
+		d.Set("frequency", configuration.Frequency),
+		d.Set("included_object_versions", configuration.IncludedObjectVersions),
+		d.Set("filter_prefix", filterPrefix),
+		d.Set("optional_fields", configuration.OptionalFields),
+		d.Set("enabled", configuration.IsEnabled),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket inventory fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketInventoryDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	bucket, configurationID, err := parseInventoryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete inventory configuration (%s) of OBS bucket %s", configurationID, bucket)
+	_, err = cfg.ObjectStorageBucketRequest(region, "DELETE", bucket,
+		map[string]string{"inventory": "", "id": configurationID}, "", nil)
+	if err != nil {
+		return diag.FromErr(getObsError("Error deleting inventory configuration of OBS bucket", bucket, err))
+	}
+	return nil
+}
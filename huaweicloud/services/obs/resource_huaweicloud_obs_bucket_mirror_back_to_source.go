@@ -0,0 +1,128 @@
+package obs
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceObsBucketMirrorBackToSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketMirrorBackToSourcePut,
+		UpdateContext: resourceObsBucketMirrorBackToSourcePut,
+		ReadContext:   resourceObsBucketMirrorBackToSourceRead,
+		DeleteContext: resourceObsBucketMirrorBackToSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.ValidateJsonString,
+				StateFunc: func(v interface{}) string {
+					jsonString, _ := utils.NormalizeJsonString(v)
+					return jsonString
+				},
+			},
+		},
+	}
+}
+
+func resourceObsBucketMirrorBackToSourcePut(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	bucket := d.Get("bucket").(string)
+
+	var rules interface{}
+	if err := json.Unmarshal([]byte(d.Get("rules").(string)), &rules); err != nil {
+		return diag.Errorf("error parsing the mirror back to source rules: %s", err)
+	}
+	body, err := json.Marshal(map[string]interface{}{"rules": rules})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = cfg.ObjectStorageBucketRequest(region, "PUT", bucket, map[string]string{"mirrorBackToSource": ""},
+		"application/json", body)
+	if err != nil {
+		return diag.FromErr(getObsError("Error setting mirror back to source rules of OBS bucket", bucket, err))
+	}
+
+	d.SetId(bucket)
+	return resourceObsBucketMirrorBackToSourceRead(ctx, d, meta)
+}
+
+func resourceObsBucketMirrorBackToSourceRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	body, err := cfg.ObjectStorageBucketRequest(region, "GET", d.Id(), map[string]string{"mirrorBackToSource": ""},
+		"", nil)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] the mirror back to source rules of OBS bucket (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error retrieving mirror back to source rules of OBS bucket", d.Id(), err))
+	}
+
+	var respBody map[string]interface{}
+	if err = json.Unmarshal(body, &respBody); err != nil {
+		return diag.Errorf("error parsing mirror back to source rules of OBS bucket %s: %s", d.Id(), err)
+	}
+	// the rules are marshaled in the same way as StateFunc to keep the format consistent
+	rules, err := json.Marshal(respBody["rules"])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("rules", string(rules)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket mirror back to source fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketMirrorBackToSourceDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	bucket := d.Id()
+
+	log.Printf("[DEBUG] delete mirror back to source rules of OBS bucket %s", bucket)
+	_, err := cfg.ObjectStorageBucketRequest(region, "DELETE", bucket, map[string]string{"mirrorBackToSource": ""},
+		"", nil)
+	if err != nil {
+		return diag.FromErr(getObsError("Error deleting mirror back to source rules of OBS bucket", bucket, err))
+	}
+	return nil
+}
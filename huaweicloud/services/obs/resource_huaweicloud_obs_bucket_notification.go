@@ -0,0 +1,264 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The OBS SDK only supports the SMN topic configurations, so the notification configuration is managed through the
+// raw requests to support the FunctionGraph configurations.
+type notificationConfiguration struct {
+	XMLName                     xml.Name                     `xml:"NotificationConfiguration"`
+	TopicConfigurations         []topicConfiguration         `xml:"TopicConfiguration"`
+	FunctionGraphConfigurations []functionGraphConfiguration `xml:"FunctionGraphConfiguration"`
+}
+
+type topicConfiguration struct {
+	ID          string           `xml:"Id,omitempty"`
+	FilterRules []obs.FilterRule `xml:"Filter>Object>FilterRule"`
+	Topic       string           `xml:"Topic"`
+	Events      []string         `xml:"Event"`
+}
+
+type functionGraphConfiguration struct {
+	ID            string           `xml:"Id,omitempty"`
+	FilterRules   []obs.FilterRule `xml:"Filter>Object>FilterRule"`
+	FunctionGraph string           `xml:"FunctionGraph"`
+	Events        []string         `xml:"Event"`
+}
+
+var notificationEvents = []string{
+	"ObjectCreated:*", "ObjectCreated:Put", "ObjectCreated:Post", "ObjectCreated:Copy",
+	"ObjectCreated:CompleteMultipartUpload", "ObjectRemoved:*", "ObjectRemoved:Delete",
+	"ObjectRemoved:DeleteMarkerCreated",
+}
+
+func ResourceObsBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketNotificationPut,
+		UpdateContext: resourceObsBucketNotificationPut,
+		ReadContext:   resourceObsBucketNotificationRead,
+		DeleteContext: resourceObsBucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_configurations": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"topic_configurations", "function_configurations"},
+				Elem: &schema.Resource{
+					Schema: notificationConfigurationSchema("topic_urn"),
+				},
+			},
+			"function_configurations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: notificationConfigurationSchema("function_urn"),
+				},
+			},
+		},
+	}
+}
+
+func notificationConfigurationSchema(targetKey string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		targetKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"events": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(notificationEvents, false),
+			},
+		},
+		"id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"prefix": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"suffix": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func buildNotificationFilterRules(rawMap map[string]interface{}) []obs.FilterRule {
+	var rules []obs.FilterRule
+	for _, name := range []string{"prefix", "suffix"} {
+		if v := rawMap[name].(string); v != "" {
+			rules = append(rules, obs.FilterRule{Name: name, Value: v})
+		}
+	}
+	return rules
+}
+
+func buildNotificationConfiguration(d *schema.ResourceData) notificationConfiguration {
+	var result notificationConfiguration
+	for _, raw := range d.Get("topic_configurations").([]interface{}) {
+		rawMap := raw.(map[string]interface{})
+		result.TopicConfigurations = append(result.TopicConfigurations, topicConfiguration{
+			ID:          rawMap["id"].(string),
+			FilterRules: buildNotificationFilterRules(rawMap),
+			Topic:       rawMap["topic_urn"].(string),
+			Events:      utils.ExpandToStringListBySet(rawMap["events"].(*schema.Set)),
+		})
+	}
+	for _, raw := range d.Get("function_configurations").([]interface{}) {
+		rawMap := raw.(map[string]interface{})
+		result.FunctionGraphConfigurations = append(result.FunctionGraphConfigurations, functionGraphConfiguration{
+			ID:            rawMap["id"].(string),
+			FilterRules:   buildNotificationFilterRules(rawMap),
+			FunctionGraph: rawMap["function_urn"].(string),
+			Events:        utils.ExpandToStringListBySet(rawMap["events"].(*schema.Set)),
+		})
+	}
+	return result
+}
+
+func putBucketNotification(cfg *config.Config, region, bucket string, configuration notificationConfiguration) error {
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+	_, err = cfg.ObjectStorageBucketRequest(region, "PUT", bucket, map[string]string{"notification": ""},
+		"application/xml", body)
+	return err
+}
+
+func resourceObsBucketNotificationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	bucket := d.Get("bucket").(string)
+
+	err := putBucketNotification(cfg, region, bucket, buildNotificationConfiguration(d))
+	if err != nil {
+		return diag.FromErr(getObsError("Error setting notification configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId(bucket)
+	return resourceObsBucketNotificationRead(ctx, d, meta)
+}
+
+func flattenNotificationFilterRules(rawMap map[string]interface{}, rules []obs.FilterRule) map[string]interface{} {
+	for _, rule := range rules {
+		if rule.Name == "prefix" || rule.Name == "suffix" {
+			rawMap[rule.Name] = rule.Value
+		}
+	}
+	return rawMap
+}
+
+func flattenTopicConfigurations(configurations []topicConfiguration) []map[string]interface{} {
+	if len(configurations) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(configurations))
+	for i, v := range configurations {
+		result[i] = flattenNotificationFilterRules(map[string]interface{}{
+			"id":        v.ID,
+			"topic_urn": v.Topic,
+			"events":    v.Events,
+		}, v.FilterRules)
+	}
+	return result
+}
+
+func flattenFunctionConfigurations(configurations []functionGraphConfiguration) []map[string]interface{} {
+	if len(configurations) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(configurations))
+	for i, v := range configurations {
+		result[i] = flattenNotificationFilterRules(map[string]interface{}{
+			"id":           v.ID,
+			"function_urn": v.FunctionGraph,
+			"events":       v.Events,
+		}, v.FilterRules)
+	}
+	return result
+}
+
+func resourceObsBucketNotificationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	body, err := cfg.ObjectStorageBucketRequest(region, "GET", d.Id(), map[string]string{"notification": ""}, "", nil)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] OBS bucket (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error retrieving notification configuration of OBS bucket", d.Id(), err))
+	}
+
+	var configuration notificationConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return diag.Errorf("error parsing notification configuration of OBS bucket %s: %s", d.Id(), err)
+	}
+	if len(configuration.TopicConfigurations) == 0 && len(configuration.FunctionGraphConfigurations) == 0 {
+		// The bucket does not have notification configurations
+		log.Printf("[WARN] the notification configuration of OBS bucket (%s) is empty", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("topic_configurations", flattenTopicConfigurations(configuration.TopicConfigurations)),
+		d.Set("function_configurations", flattenFunctionConfigurations(configuration.FunctionGraphConfigurations)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket notification fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketNotificationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	bucket := d.Id()
+
+	// the notification configuration is removed by setting an empty configuration
+	log.Printf("[DEBUG] delete notification configuration of OBS bucket %s", bucket)
+	if err := putBucketNotification(cfg, region, bucket, notificationConfiguration{}); err != nil {
+		return diag.FromErr(getObsError("Error deleting notification configuration of OBS bucket", bucket, err))
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+type objectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled"`
+	Rule              *objectLockRule `xml:"Rule,omitempty"`
+}
+
+type objectLockRule struct {
+	DefaultRetention objectLockRetention `xml:"DefaultRetention"`
+}
+
+type objectLockRetention struct {
+	Mode  string `xml:"Mode"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+func ResourceObsBucketObjectLock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketObjectLockPut,
+		UpdateContext: resourceObsBucketObjectLockPut,
+		ReadContext:   resourceObsBucketObjectLockRead,
+		DeleteContext: resourceObsBucketObjectLockDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "COMPLIANCE",
+				ValidateFunc: validation.StringInSlice([]string{"COMPLIANCE"}, false),
+			},
+			"days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 36500),
+				ExactlyOneOf: []string{"days", "years"},
+			},
+			"years": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+		},
+	}
+}
+
+func putBucketObjectLock(cfg *config.Config, region, bucket string, configuration objectLockConfiguration) error {
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+	_, err = cfg.ObjectStorageBucketRequest(region, "PUT", bucket, map[string]string{"object-lock": ""},
+		"application/xml", body)
+	return err
+}
+
+func resourceObsBucketObjectLockPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	bucket := d.Get("bucket").(string)
+
+	configuration := objectLockConfiguration{
+		ObjectLockEnabled: "Enabled",
+		Rule: &objectLockRule{
+			DefaultRetention: objectLockRetention{
+				Mode:  d.Get("mode").(string),
+				Days:  d.Get("days").(int),
+				Years: d.Get("years").(int),
+			},
+		},
+	}
+	if err := putBucketObjectLock(cfg, region, bucket, configuration); err != nil {
+		return diag.FromErr(getObsError("Error setting WORM configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId(bucket)
+	return resourceObsBucketObjectLockRead(ctx, d, meta)
+}
+
+func resourceObsBucketObjectLockRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	body, err := cfg.ObjectStorageBucketRequest(region, "GET", d.Id(), map[string]string{"object-lock": ""}, "", nil)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] the WORM configuration of OBS bucket (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error retrieving WORM configuration of OBS bucket", d.Id(), err))
+	}
+
+	var configuration objectLockConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return diag.Errorf("error parsing WORM configuration of OBS bucket %s: %s", d.Id(), err)
+	}
+	if configuration.Rule == nil {
+		// The bucket does not have the default retention
+		log.Printf("[WARN] the default WORM retention of OBS bucket (%s) is empty", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	retention := configuration.Rule.DefaultRetention
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("mode", retention.Mode),
+		d.Set("days", retention.Days),
+		d.Set("years", retention.Years),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket WORM fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketObjectLockDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	bucket := d.Id()
+
+	// WORM cannot be disabled once it is enabled, so only the default retention is removed
+	log.Printf("[DEBUG] delete the default WORM retention of OBS bucket %s", bucket)
+	configuration := objectLockConfiguration{
+		ObjectLockEnabled: "Enabled",
+	}
+	if err := putBucketObjectLock(cfg, region, bucket, configuration); err != nil {
+		return diag.FromErr(getObsError("Error deleting WORM configuration of OBS bucket", bucket, err))
+	}
+	return nil
+}
@@ -0,0 +1,665 @@
+package obs
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// the maximum number of objects that can be deleted in one request
+const maxObjectsPerDeletion = 1000
+
+func ResourceObsBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketObjectsSyncCreate,
+		ReadContext:   resourceObsBucketObjectsSyncRead,
+		UpdateContext: resourceObsBucketObjectsSyncUpdate,
+		DeleteContext: resourceObsBucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceObsBucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"STANDARD", "WARM", "COLD",
+				}, false),
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntBetween(5, 5120),
+			},
+			// the MD5 of the local files which have been synchronized, keyed by the object keys
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// the ETags returned by OBS, which are used to detect the objects changed out of band
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// syncFilter filters the relative paths of the local files and the objects with the include and exclude patterns.
+type syncFilter struct {
+	includes []*regexp.Regexp
+	excludes []*regexp.Regexp
+}
+
+// globToRegexp converts a glob pattern to a regular expression. The "*" and "?" do not match the path separator,
+// and "**" matches any number of directories.
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// "**/" matches zero or more directories
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+func buildSyncFilter(includes, excludes []interface{}) (*syncFilter, error) {
+	var filter syncFilter
+	for _, v := range includes {
+		re, err := globToRegexp(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid include pattern (%s): %s", v, err)
+		}
+		filter.includes = append(filter.includes, re)
+	}
+	for _, v := range excludes {
+		re, err := globToRegexp(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern (%s): %s", v, err)
+		}
+		filter.excludes = append(filter.excludes, re)
+	}
+	return &filter, nil
+}
+
+func (f *syncFilter) match(relPath string) bool {
+	for _, re := range f.excludes {
+		if re.MatchString(relPath) {
+			return false
+		}
+	}
+	if len(f.includes) == 0 {
+		return true
+	}
+	for _, re := range f.includes {
+		if re.MatchString(relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+type syncAttributes interface {
+	Get(key string) interface{}
+}
+
+func buildSyncFilterByAttributes(d syncAttributes) (*syncFilter, error) {
+	return buildSyncFilter(d.Get("include").([]interface{}), d.Get("exclude").([]interface{}))
+}
+
+func fileMD5(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// listLocalFiles returns the MD5 of the local files to be synchronized, keyed by the object keys.
+func listLocalFiles(d syncAttributes) (map[string]string, error) {
+	sourceDir := d.Get("source_dir").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	filter, err := buildSyncFilterByAttributes(d)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	err = filepath.WalkDir(sourceDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if !filter.match(relPath) {
+			return nil
+		}
+
+		hash, err := fileMD5(filePath)
+		if err != nil {
+			return err
+		}
+		result[keyPrefix+relPath] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading the source directory (%s): %s", sourceDir, err)
+	}
+	return result, nil
+}
+
+func resourceObsBucketObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// the source directory may not exist before the resources it depends on are created
+	if !d.NewValueKnown("source_dir") {
+		return d.SetNewComputed("files")
+	}
+
+	localFiles, err := listLocalFiles(d)
+	if err != nil {
+		return err
+	}
+
+	oldFiles := d.Get("files").(map[string]interface{})
+	changed := len(oldFiles) != len(localFiles)
+	for k, v := range localFiles {
+		if oldFiles[k] != v {
+			changed = true
+			break
+		}
+	}
+	if changed || d.Id() == "" {
+		return d.SetNew("files", localFiles)
+	}
+	return nil
+}
+
+func resourceObsBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	obsClient, err := cfg.ObjectStorageClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	_, err = obsClient.HeadBucket(bucket)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			return diag.Errorf("OBS bucket(%s) not found", bucket)
+		}
+		return diag.Errorf("error reading OBS bucket %s: %s", bucket, err)
+	}
+
+	localFiles, err := listLocalFiles(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, d.Get("key_prefix").(string)))
+	if err := syncBucketObjects(obsClient, d, localFiles, true); err != nil {
+		if len(d.Get("files").(map[string]interface{})) == 0 {
+			// nothing has been synchronized, so the resource is not created
+			d.SetId("")
+			return diag.FromErr(err)
+		}
+		// returning an error would taint the resource and upload all objects again in the next apply
+		return readObsBucketObjectsSyncWithWarning(ctx, d, meta, err)
+	}
+	return resourceObsBucketObjectsSyncRead(ctx, d, meta)
+}
+
+// readObsBucketObjectsSyncWithWarning reads the resource and reports the partial failure of the synchronization as a
+// warning, the failed objects are recorded as unsynchronized and will be synchronized in the next apply.
+func readObsBucketObjectsSyncWithWarning(ctx context.Context, d *schema.ResourceData, meta interface{},
+	syncErr error) diag.Diagnostics {
+	diags := resourceObsBucketObjectsSyncRead(ctx, d, meta)
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Some objects failed to synchronize, they will be synchronized in the next apply",
+		Detail:   syncErr.Error(),
+	})
+}
+
+func resourceObsBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	obsClient, err := cfg.ObjectStorageClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	localFiles, err := listLocalFiles(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// all objects are uploaded again if the object properties are changed
+	uploadAll := d.HasChanges("content_types", "acl", "storage_class")
+	if err := syncBucketObjects(obsClient, d, localFiles, uploadAll); err != nil {
+		// the objects which have been synchronized are recorded, so the partial failure is also reported as a warning
+		return readObsBucketObjectsSyncWithWarning(ctx, d, meta, err)
+	}
+	return resourceObsBucketObjectsSyncRead(ctx, d, meta)
+}
+
+func getStringMap(d *schema.ResourceData, key string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range d.Get(key).(map[string]interface{}) {
+		result[k] = v.(string)
+	}
+	return result
+}
+
+// syncBucketObjects uploads the local files which are changed and deletes the objects which are no longer in the
+// source directory, the localFiles are the MD5 of the local files keyed by the object keys. The files and etags only
+// record the objects which have been synchronized, so the failed ones remain different from the local files and are
+// retried in the next apply.
+func syncBucketObjects(obsClient *obs.ObsClient, d *schema.ResourceData, localFiles map[string]string,
+	uploadAll bool) error {
+	bucket := d.Get("bucket").(string)
+
+	oldFilesRaw, _ := d.GetChange("files")
+	oldFiles := make(map[string]string)
+	for k, v := range oldFilesRaw.(map[string]interface{}) {
+		oldFiles[k] = v.(string)
+	}
+	files := oldFiles
+	etags := getStringMap(d, "etags")
+
+	var toUpload []string
+	for k, v := range localFiles {
+		if uploadAll || oldFiles[k] != v {
+			toUpload = append(toUpload, k)
+		}
+	}
+	var toDelete []string
+	for k := range oldFiles {
+		if _, ok := localFiles[k]; !ok {
+			toDelete = append(toDelete, k)
+		}
+	}
+
+	log.Printf("[DEBUG] sync OBS bucket %s: %d objects to upload, %d objects to delete", bucket,
+		len(toUpload), len(toDelete))
+	uploaded, uploadErr := uploadBucketObjects(obsClient, d, toUpload)
+	for k, etag := range uploaded {
+		files[k] = localFiles[k]
+		etags[k] = etag
+	}
+	deleted, deleteErr := deleteBucketObjects(obsClient, bucket, toDelete)
+	for _, k := range deleted {
+		delete(files, k)
+		delete(etags, k)
+	}
+
+	mErr := multierror.Append(uploadErr, deleteErr)
+	mErr = multierror.Append(mErr,
+		d.Set("files", files),
+		d.Set("etags", etags),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func getObjectContentType(d *schema.ResourceData, key string) string {
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	ext := path.Ext(key)
+	if v, ok := contentTypes[ext]; ok {
+		return v.(string)
+	}
+	// the extension can also be specified without the leading dot
+	if v, ok := contentTypes[strings.TrimPrefix(ext, ".")]; ok {
+		return v.(string)
+	}
+	// the content type is detected by the OBS SDK through the file extension
+	return ""
+}
+
+func uploadBucketObject(obsClient *obs.ObsClient, d *schema.ResourceData, key string, taskNum int) (string, error) {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	sourceFile := filepath.Join(d.Get("source_dir").(string), filepath.FromSlash(strings.TrimPrefix(key, keyPrefix)))
+	partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+
+	fileInfo, err := os.Stat(sourceFile)
+	if err != nil {
+		return "", err
+	}
+
+	operationInput := obs.ObjectOperationInput{
+		Bucket:       bucket,
+		Key:          key,
+		ACL:          obs.AclType(d.Get("acl").(string)),
+		StorageClass: obs.StorageClassType(d.Get("storage_class").(string)),
+	}
+	contentType := getObjectContentType(d, key)
+
+	if fileInfo.Size() <= partSize {
+		putInput := &obs.PutFileInput{
+			SourceFile: sourceFile,
+		}
+		putInput.ObjectOperationInput = operationInput
+		putInput.ContentType = contentType
+		resp, err := obsClient.PutFile(putInput)
+		if err != nil {
+			return "", err
+		}
+		return strings.Trim(resp.ETag, `"`), nil
+	}
+
+	uploadInput := &obs.UploadFileInput{
+		ObjectOperationInput: operationInput,
+		ContentType:          contentType,
+		UploadFile:           sourceFile,
+		PartSize:             partSize,
+		TaskNum:              taskNum,
+	}
+	resp, err := obsClient.UploadFile(uploadInput)
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(resp.ETag, `"`), nil
+}
+
+// getUploadConcurrency divides the concurrency into the number of objects uploaded in parallel and the number of parts
+// uploaded in parallel for each object, so that the total number of connections does not exceed the concurrency.
+func getUploadConcurrency(concurrency, count int) (workers, taskNum int) {
+	workers = concurrency
+	if count < workers {
+		workers = count
+	}
+	if workers < 1 {
+		return 0, concurrency
+	}
+	return workers, concurrency / workers
+}
+
+// uploadBucketObjects uploads the objects in parallel and returns the ETags of the uploaded objects.
+func uploadBucketObjects(obsClient *obs.ObsClient, d *schema.ResourceData, keys []string) (map[string]string, error) {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		mErr    *multierror.Error
+		results = make(map[string]string)
+		keyChan = make(chan string)
+	)
+
+	workers, taskNum := getUploadConcurrency(d.Get("concurrency").(int), len(keys))
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keyChan {
+				log.Printf("[DEBUG] uploading %s to OBS bucket %s", key, d.Get("bucket").(string))
+				etag, err := uploadBucketObject(obsClient, d, key, taskNum)
+
+				mu.Lock()
+				if err != nil {
+					mErr = multierror.Append(mErr, fmt.Errorf("error uploading object %s: %s", key, err))
+				} else {
+					results[key] = etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		keyChan <- key
+	}
+	close(keyChan)
+	wg.Wait()
+
+	return results, mErr.ErrorOrNil()
+}
+
+// deleteBucketObjects deletes the objects in batches and returns the keys of the deleted objects.
+func deleteBucketObjects(obsClient *obs.ObsClient, bucket string, keys []string) ([]string, error) {
+	var deleted []string
+	var mErr *multierror.Error
+	for start := 0; start < len(keys); start += maxObjectsPerDeletion {
+		end := start + maxObjectsPerDeletion
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		input := &obs.DeleteObjectsInput{
+			Bucket: bucket,
+			Quiet:  true,
+		}
+		for _, key := range keys[start:end] {
+			input.Objects = append(input.Objects, obs.ObjectToDelete{Key: key})
+		}
+
+		resp, err := obsClient.DeleteObjects(input)
+		if err != nil {
+			mErr = multierror.Append(mErr, getObsError("Error deleting objects of OBS bucket", bucket, err))
+			continue
+		}
+
+		// only the failed objects are returned in the quiet mode
+		failed := make(map[string]bool)
+		for _, e := range resp.Errors {
+			failed[e.Key] = true
+			mErr = multierror.Append(mErr, fmt.Errorf("error deleting object %s: %s", e.Key, e.Message))
+		}
+		for _, key := range keys[start:end] {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+	return deleted, mErr.ErrorOrNil()
+}
+
+// listBucketObjects returns the ETags of the objects with the specified prefix, keyed by the object keys.
+func listBucketObjects(obsClient *obs.ObsClient, bucket, prefix string) (map[string]string, error) {
+	result := make(map[string]string)
+	input := &obs.ListObjectsInput{
+		Bucket: bucket,
+	}
+	input.Prefix = prefix
+	input.MaxKeys = 1000
+
+	for {
+		resp, err := obsClient.ListObjects(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, content := range resp.Contents {
+			result[content.Key] = strings.Trim(content.ETag, `"`)
+		}
+		if !resp.IsTruncated {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+	return result, nil
+}
+
+func resourceObsBucketObjectsSyncRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	obsClient, err := cfg.ObjectStorageClient(region)
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	remoteObjects, err := listBucketObjects(obsClient, bucket, keyPrefix)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] OBS bucket (%s) not found", bucket)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(getObsError("Error listing objects of OBS bucket", bucket, err))
+	}
+
+	files := getStringMap(d, "files")
+	etags := getStringMap(d, "etags")
+	for key := range files {
+		etag, ok := remoteObjects[key]
+		if !ok {
+			log.Printf("[WARN] object %s not found in OBS bucket %s", key, bucket)
+			delete(files, key)
+			delete(etags, key)
+			continue
+		}
+		if etag != etags[key] {
+			// the object has been changed out of band, clean the MD5 to upload it again
+			log.Printf("[WARN] object %s in OBS bucket %s has been changed", key, bucket)
+			files[key] = ""
+			etags[key] = etag
+		}
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		filter, err := buildSyncFilterByAttributes(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// the extraneous objects are recorded without MD5, so they will be deleted in the next apply
+		for key, etag := range remoteObjects {
+			if _, ok := files[key]; ok || !filter.match(strings.TrimPrefix(key, keyPrefix)) {
+				continue
+			}
+			files[key] = ""
+			etags[key] = etag
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("files", files),
+		d.Set("etags", etags),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket objects sync fields: %s", err)
+	}
+	return nil
+}
+
+func resourceObsBucketObjectsSyncDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	obsClient, err := cfg.ObjectStorageClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	files := d.Get("files").(map[string]interface{})
+	keys := make([]string, 0, len(files))
+	for k := range files {
+		keys = append(keys, k)
+	}
+
+	log.Printf("[DEBUG] delete %d synchronized objects of OBS bucket %s", len(keys), bucket)
+	if _, err := deleteBucketObjects(obsClient, bucket, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package obs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobToRegexp(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		path    string
+		matched bool
+	}{
+		{"*.txt", "a.txt", true},
+		{"*.txt", "dir/a.txt", false},
+		{"dir/*", "dir/a.txt", true},
+		{"dir/*", "dir/sub/a.txt", false},
+		{"**/*.txt", "a.txt", true},
+		{"**/*.txt", "dir/sub/a.txt", true},
+		{"**/*.txt", "dir/sub/a.json", false},
+		{"dir/**", "dir/sub/a.txt", true},
+		{"dir/**", "other/a.txt", false},
+		{"dir/**/*.go", "dir/main.go", true},
+		{"dir/**/*.go", "dir/a/b/main.go", true},
+		{"dir/**/*.go", "dirmain.go", false},
+		{"?.js", "a.js", true},
+		{"?.js", "ab.js", false},
+		{"a?b", "a/b", false},
+		{"file[1].txt", "file[1].txt", true},
+		{"file[1].txt", "file1.txt", false},
+		{"a+b.txt", "aab.txt", false},
+	}
+
+	for _, tc := range testCases {
+		re, err := globToRegexp(tc.pattern)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.matched, re.MatchString(tc.path), "pattern %q, path %q", tc.pattern, tc.path)
+	}
+}
+
+func TestSyncFilterMatch(t *testing.T) {
+	testCases := []struct {
+		includes []interface{}
+		excludes []interface{}
+		path     string
+		matched  bool
+	}{
+		{nil, nil, "dir/a.txt", true},
+		{[]interface{}{"**/*.txt"}, nil, "dir/a.txt", true},
+		{[]interface{}{"**/*.txt"}, nil, "dir/a.json", false},
+		{nil, []interface{}{"**/*.tmp"}, "dir/a.tmp", false},
+		{nil, []interface{}{"**/*.tmp"}, "dir/a.txt", true},
+		// the exclude patterns take precedence over the include patterns
+		{[]interface{}{"dir/**"}, []interface{}{"dir/tmp/**"}, "dir/tmp/a.txt", false},
+		{[]interface{}{"dir/**"}, []interface{}{"dir/tmp/**"}, "dir/src/a.txt", true},
+		{[]interface{}{"*.txt", "*.json"}, nil, "a.json", true},
+	}
+
+	for _, tc := range testCases {
+		filter, err := buildSyncFilter(tc.includes, tc.excludes)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.matched, filter.match(tc.path), "includes %v, excludes %v, path %q",
+			tc.includes, tc.excludes, tc.path)
+	}
+}
+
+func TestGetUploadConcurrency(t *testing.T) {
+	testCases := []struct {
+		concurrency int
+		count       int
+		workers     int
+		taskNum     int
+	}{
+		{10, 0, 0, 10},
+		{10, 1, 1, 10},
+		{10, 3, 3, 3},
+		{10, 4, 4, 2},
+		{10, 10, 10, 1},
+		{10, 20, 10, 1},
+		{1, 5, 1, 1},
+	}
+
+	for _, tc := range testCases {
+		workers, taskNum := getUploadConcurrency(tc.concurrency, tc.count)
+		assert.Equal(t, tc.workers, workers, "concurrency %d, count %d", tc.concurrency, tc.count)
+		assert.Equal(t, tc.taskNum, taskNum, "concurrency %d, count %d", tc.concurrency, tc.count)
+	}
+}
@@ -1,10 +1,13 @@
 package rds
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 type requestErr struct {
@@ -49,3 +52,18 @@ func handleMultiOperationsError(err error) (bool, error) {
 	// Operation execution failed due to some resource or server issues, no need to try again.
 	return false, err
 }
+
+// retryMultiOperations executes the operation of the RDS instance and retries it until the other operations of the
+// instance are completed or the timeout is reached.
+func retryMultiOperations(ctx context.Context, timeout time.Duration, operation func() error) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		retryable, err := handleMultiOperationsError(operation())
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
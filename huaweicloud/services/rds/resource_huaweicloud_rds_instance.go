@@ -35,6 +35,8 @@ func ResourceRdsInstance() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRdsInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(30 * time.Minute),
 			Update:  schema.DefaultTimeout(30 * time.Minute),
@@ -303,6 +305,39 @@ func ResourceRdsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"restore.0.backup_id", "restore.0.restore_time"},
+						},
+						"restore_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"database_name_map": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"in_place": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			// charge info: charging_mode, period_unit, period, auto_renew, auto_pay
 			"charging_mode": common.SchemaChargingMode(nil),
 			"period_unit":   common.SchemaPeriodUnit(nil),
@@ -380,7 +415,12 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("db.0.password").(string)
 
-	res, err := instances.Create(client, createOpts).Extract()
+	var res *instances.CreateResponse
+	if _, ok := d.GetOk("restore"); ok {
+		res, err = restoreRdsInstanceToNew(d, client, createOpts)
+	} else {
+		res, err = instances.Create(client, createOpts).Extract()
+	}
 	if err != nil {
 		return diag.Errorf("error creating RDS instance: %s", err)
 	}
@@ -728,6 +768,10 @@ func resourceRdsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := restoreRdsInstanceInPlace(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("tags") {
 		tagErr := utils.UpdateResourceTags(client, d, "instances", instanceID)
 		if tagErr != nil {
@@ -1228,3 +1272,101 @@ func parameterToHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return hashcode.String(m["name"].(string) + m["value"].(string))
 }
+
+// rdsRestoreKeys are the restore parameters which specify the data to be restored.
+var rdsRestoreKeys = []string{
+	"restore.0.source_instance_id", "restore.0.backup_id", "restore.0.restore_time", "restore.0.database_name_map",
+}
+
+func resourceRdsInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// removing the restore block does not change the data of the instance
+	if d.Id() == "" || !d.HasChanges(rdsRestoreKeys...) || len(d.Get("restore").([]interface{})) == 0 {
+		return nil
+	}
+
+	if !d.Get("restore.0.in_place").(bool) {
+		for _, key := range rdsRestoreKeys {
+			if d.HasChange(key) {
+				if err := d.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	dbType := strings.ToLower(d.Get("db.0.type").(string))
+	if dbType != "mysql" && dbType != "postgresql" {
+		return fmt.Errorf("the in-place restore is only supported by MySQL and PostgreSQL, but the database type "+
+			"is %s", d.Get("db.0.type"))
+	}
+	return nil
+}
+
+func buildRdsInstanceRestorePoint(d *schema.ResourceData) map[string]interface{} {
+	restorePoint := map[string]interface{}{
+		"instance_id":   d.Get("restore.0.source_instance_id"),
+		"database_name": utils.ValueIngoreEmpty(d.Get("restore.0.database_name_map")),
+	}
+	if v, ok := d.GetOk("restore.0.backup_id"); ok {
+		restorePoint["type"] = "backup"
+		restorePoint["backup_id"] = v
+	} else {
+		restorePoint["type"] = "timestamp"
+		restorePoint["restore_time"] = d.Get("restore.0.restore_time")
+	}
+	return restorePoint
+}
+
+// restoreRdsInstanceToNew creates a new instance with the data of the backup or the point in time of the source
+// instance, the request is the same as creating an instance except the restore point.
+func restoreRdsInstanceToNew(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	createOpts instances.CreateOpts) (*instances.CreateResponse, error) {
+	body, err := createOpts.ToInstancesCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	// the database engine of the new instance is the same as the source instance
+	delete(body, "datastore")
+	body["restore_point"] = utils.RemoveNil(buildRdsInstanceRestorePoint(d))
+
+	var res instances.CreateResponse
+	_, err = client.Post(client.ServiceURL("instances"), body, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error restoring from the source instance (%s): %s",
+			d.Get("restore.0.source_instance_id"), err)
+	}
+	return &res, nil
+}
+
+// restoreRdsInstanceInPlace restores the data of the backup or the point in time of the source instance to the
+// existing instance, all data of the existing instance will be overwritten.
+func restoreRdsInstanceInPlace(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChanges(rdsRestoreKeys...) || !d.Get("restore.0.in_place").(bool) {
+		return nil
+	}
+
+	restoreOpts := map[string]interface{}{
+		"source": utils.RemoveNil(buildRdsInstanceRestorePoint(d)),
+		"target": map[string]interface{}{
+			"instance_id": instanceID,
+		},
+	}
+
+	var res struct {
+		JobId string `json:"job_id"`
+	}
+	_, err := client.Post(client.ServiceURL("instances", "recovery"), restoreOpts, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return fmt.Errorf("error restoring RDS instance (%s) in place: %s", instanceID, err)
+	}
+
+	if err = checkRDSInstanceJobFinish(client, res.JobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error restoring RDS instance (%s) in place: %s", instanceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourcePgAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePgAccountCreate,
+		UpdateContext: resourcePgAccountUpdate,
+		DeleteContext: resourcePgAccountDelete,
+		ReadContext:   resourcePgAccountRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rolcreaterole": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"rolcreatedb": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"rolcanlogin": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"rolreplication": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"rolsuper": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"rolinherit": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"rolconnlimit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rolbypassrls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"memberof": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// pgRolePrivileges is the mapping from the configurable attribute flags to the role privileges of the API, the value
+// with prefix NO revokes the privilege, e.g. NOCREATEDB.
+var pgRolePrivileges = map[string]string{
+	"rolcreaterole":  "CREATEROLE",
+	"rolcreatedb":    "CREATEDB",
+	"rolcanlogin":    "LOGIN",
+	"rolreplication": "REPLICATION",
+}
+
+// pgUserAttributes is the attribute flags of the PostgreSQL role.
+type pgUserAttributes struct {
+	RolSuper       bool `json:"rolsuper"`
+	RolInherit     bool `json:"rolinherit"`
+	RolCreateRole  bool `json:"rolcreaterole"`
+	RolCreateDb    bool `json:"rolcreatedb"`
+	RolCanLogin    bool `json:"rolcanlogin"`
+	RolConnLimit   int  `json:"rolconnlimit"`
+	RolReplication bool `json:"rolreplication"`
+	RolBypassRls   bool `json:"rolbypassrls"`
+}
+
+func resourcePgAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	dbUser := d.Get("name").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	createOpts := &rds.CreatePostgresqlDbUserRequest{
+		InstanceId: instanceId,
+		Body: &rds.PostgresqlUserForCreation{
+			Name:     dbUser,
+			Password: d.Get("password").(string),
+		},
+	}
+
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.CreatePostgresqlDbUser(createOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL account: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbUser)
+
+	if err = updatePgAccountAttributes(ctx, c, d, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePgAccountRead(ctx, d, meta)
+}
+
+// getConfiguredPgAttributes returns the attribute flags which are specified in the configuration.
+func getConfiguredPgAttributes(d *schema.ResourceData) map[string]bool {
+	rawAttributes := d.GetRawConfig().GetAttr("attributes")
+	if !rawAttributes.IsKnown() || rawAttributes.IsNull() || rawAttributes.LengthInt() == 0 {
+		return nil
+	}
+
+	rawAttribute := rawAttributes.Index(cty.NumberIntVal(0))
+	result := make(map[string]bool)
+	for key := range pgRolePrivileges {
+		if v := rawAttribute.GetAttr(key); v.IsKnown() && !v.IsNull() {
+			result[key] = v.True()
+		}
+	}
+	return result
+}
+
+// updatePgAccountAttributes grants or revokes the role privileges of the attribute flags which are specified in the
+// configuration and different from the state.
+func updatePgAccountAttributes(ctx context.Context, cfg *config.Config, d *schema.ResourceData,
+	timeoutKey string) error {
+	attributes := getConfiguredPgAttributes(d)
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	client, err := cfg.NewServiceClient("rds", cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/db-user-privilege"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{instance_id}", d.Get("instance_id").(string))
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		attributePath := fmt.Sprintf("attributes.0.%s", key)
+		if d.Id() != "" && !d.IsNewResource() && !d.HasChange(attributePath) {
+			continue
+		}
+
+		privilege := pgRolePrivileges[key]
+		if !attributes[key] {
+			privilege = "NO" + privilege
+		}
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200},
+			JSONBody: map[string]interface{}{
+				"user_name":      d.Get("name"),
+				"authority_type": "ROLE",
+				"privilege":      privilege,
+			},
+		}
+		err = retryMultiOperations(ctx, d.Timeout(timeoutKey), func() error {
+			_, err := client.Request("PUT", updatePath, &updateOpt)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("error updating the privilege %s of RDS PostgreSQL account: %s", privilege, err)
+		}
+	}
+	return nil
+}
+
+func resourcePgAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	instanceId := parts[0]
+	dbUser := parts[1]
+
+	user, err := QueryPgAccount(client, instanceId, dbUser)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL accounts")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("name", user.Name),
+		d.Set("attributes", flattenPgUserAttributes(user.Attributes)),
+		d.Set("memberof", user.Memberof),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS PostgreSQL account fields: %s", err)
+	}
+
+	return nil
+}
+
+func flattenPgUserAttributes(attributes *interface{}) []map[string]interface{} {
+	if attributes == nil {
+		return nil
+	}
+
+	// the attributes is returned as a JSON object, convert it to the structure by the JSON tags
+	b, err := json.Marshal(*attributes)
+	if err != nil {
+		log.Printf("[WARN] failed to marshal the attributes of PostgreSQL account: %s", err)
+		return nil
+	}
+	var attrs pgUserAttributes
+	if err = json.Unmarshal(b, &attrs); err != nil {
+		log.Printf("[WARN] failed to unmarshal the attributes of PostgreSQL account: %s", err)
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"rolsuper":       attrs.RolSuper,
+			"rolinherit":     attrs.RolInherit,
+			"rolcreaterole":  attrs.RolCreateRole,
+			"rolcreatedb":    attrs.RolCreateDb,
+			"rolcanlogin":    attrs.RolCanLogin,
+			"rolconnlimit":   attrs.RolConnLimit,
+			"rolreplication": attrs.RolReplication,
+			"rolbypassrls":   attrs.RolBypassRls,
+		},
+	}
+}
+
+func resourcePgAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	if d.HasChange("password") {
+		updateOpts := &rds.SetPostgresqlDbUserPwdRequest{
+			InstanceId: instanceId,
+			Body: &rds.DbUserPwdRequest{
+				Name:     d.Get("name").(string),
+				Password: d.Get("password").(string),
+			},
+		}
+
+		err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+			_, err := client.SetPostgresqlDbUserPwd(updateOpts)
+			return err
+		})
+		if err != nil {
+			return diag.Errorf("error updating RDS PostgreSQL account: %s", err)
+		}
+	}
+
+	if d.HasChange("attributes") {
+		if err = updatePgAccountAttributes(ctx, c, d, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePgAccountRead(ctx, d, meta)
+}
+
+func resourcePgAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deleteOpts := &rds.DeleteDbUserRequest{
+		InstanceId: instanceId,
+		UserName:   d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS PostgreSQL account options: %#v", deleteOpts)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.DeleteDbUser(deleteOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS PostgreSQL account: %s", err)
+	}
+
+	return nil
+}
+
+func QueryPgAccount(client *v3.RdsClient, instanceId, userName string) (*rds.PostgresqlUserForList, error) {
+	request := rds.ListPostgresqlDbUserPaginatedRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	// List all accounts
+	for {
+		response, err := client.ListPostgresqlDbUserPaginated(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+
+		users := *response.Users
+		request.Page += 1
+		for _, user := range users {
+			if user.Name == userName {
+				return &user, nil
+			}
+		}
+	}
+
+	return nil, golangsdk.ErrDefault404{}
+}
@@ -0,0 +1,227 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePgDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePgDatabaseCreate,
+		DeleteContext: resourcePgDatabaseDelete,
+		ReadContext:   resourcePgDatabaseRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"template": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"lc_collate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"lc_ctype": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"is_revoke_public_privilege": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePgDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	dbName := d.Get("name").(string)
+	createOpts := rds.PostgresqlDatabaseForCreation{
+		Name:         dbName,
+		Owner:        utils.StringIgnoreEmpty(d.Get("owner").(string)),
+		CharacterSet: utils.StringIgnoreEmpty(d.Get("character_set").(string)),
+		Template:     utils.StringIgnoreEmpty(d.Get("template").(string)),
+		LcCollate:    utils.StringIgnoreEmpty(d.Get("lc_collate").(string)),
+		LcCtype:      utils.StringIgnoreEmpty(d.Get("lc_ctype").(string)),
+	}
+	if d.Get("is_revoke_public_privilege").(bool) {
+		createOpts.IsRevokePublicPrivilege = utils.Bool(true)
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL database options: %#v", createOpts)
+
+	createDatabaseReq := rds.CreatePostgresqlDatabaseRequest{
+		InstanceId: instanceId,
+		Body:       &createOpts,
+	}
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.CreatePostgresqlDatabase(&createDatabaseReq)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL database: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbName)
+	return resourcePgDatabaseRead(ctx, d, meta)
+}
+
+func resourcePgDatabaseRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	db, err := QueryPgDatabase(client, instanceId, dbName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL databases")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("name", dbName),
+		d.Set("owner", db.Owner),
+		d.Set("character_set", db.CharacterSet),
+		d.Set("lc_collate", db.CollateSet),
+		d.Set("size", db.Size),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS PostgreSQL database fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePgDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deleteOpts := rds.DeleteDatabaseRequest{
+		InstanceId: instanceId,
+		DbName:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS PostgreSQL database options: %#v", deleteOpts)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.DeleteDatabase(&deleteOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS PostgreSQL database: %s", err)
+	}
+
+	return nil
+}
+
+func QueryPgDatabase(client *v3.RdsClient, instanceId, dbName string) (*rds.PostgresqlListDatabase, error) {
+	request := rds.ListPostgresqlDatabasesRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	// List all databases
+	for {
+		response, err := client.ListPostgresqlDatabases(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Databases == nil || len(*response.Databases) == 0 {
+			break
+		}
+
+		databases := *response.Databases
+		request.Page += 1
+		for _, db := range databases {
+			if db.Name != nil && *db.Name == dbName {
+				return &db, nil
+			}
+		}
+	}
+
+	return nil, golangsdk.ErrDefault404{}
+}
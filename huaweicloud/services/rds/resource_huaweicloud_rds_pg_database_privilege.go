@@ -0,0 +1,239 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourcePgDatabasePrivilege() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePgDatabasePrivilegeCreate,
+		DeleteContext: resourcePgDatabasePrivilegeDelete,
+		ReadContext:   resourcePgDatabasePrivilegeRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildPgUserOpts(rawUsers []interface{}) []rds.PostgresqlUserWithPrivilege {
+	if len(rawUsers) < 1 {
+		return nil
+	}
+
+	usersOpts := make([]rds.PostgresqlUserWithPrivilege, len(rawUsers))
+	for i, v := range rawUsers {
+		user := v.(map[string]interface{})
+		usersOpts[i] = rds.PostgresqlUserWithPrivilege{
+			Name:       user["name"].(string),
+			SchemaName: user["schema_name"].(string),
+			Readonly:   user["readonly"].(bool),
+		}
+	}
+	return usersOpts
+}
+
+func resourcePgDatabasePrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	createOpts := rds.PostgresqlGrantRequest{
+		DbName: dbName,
+		Users:  buildPgUserOpts(d.Get("users").(*schema.Set).List()),
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL database privilege options: %#v", createOpts)
+
+	privilegeReq := rds.AllowDbPrivilegeRequest{
+		InstanceId: instanceId,
+		Body:       &createOpts,
+	}
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.AllowDbPrivilege(&privilegeReq)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL database privilege: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbName)
+	return resourcePgDatabasePrivilegeRead(ctx, d, meta)
+}
+
+// flattenPgPrivilegeUsers returns the configured users which are still authorized to the database, the readonly of
+// each user is refreshed from the API, so a revoked user or a changed readonly results in a replacement.
+func flattenPgPrivilegeUsers(configured []interface{}, authorized []rds.UserWithPrivilege) []map[string]interface{} {
+	readonlyMap := make(map[string]bool, len(authorized))
+	for _, user := range authorized {
+		readonlyMap[user.Name] = user.Readonly
+	}
+
+	result := make([]map[string]interface{}, 0, len(configured))
+	for _, v := range configured {
+		user := v.(map[string]interface{})
+		name := user["name"].(string)
+		readonly, ok := readonlyMap[name]
+		if !ok {
+			log.Printf("[WARN] the account (%s) is not authorized to the database any more", name)
+			continue
+		}
+		// The API does not return the schema, keep the configured one.
+		result = append(result, map[string]interface{}{
+			"name":        name,
+			"schema_name": user["schema_name"],
+			"readonly":    readonly,
+		})
+	}
+	return result
+}
+
+func resourcePgDatabasePrivilegeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	if _, err = QueryPgDatabase(client, instanceId, dbName); err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL databases")
+	}
+	authorizedUsers, err := QueryDatabaseUsers(client, instanceId, dbName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL database authorized users")
+	}
+
+	users := flattenPgPrivilegeUsers(d.Get("users").(*schema.Set).List(), authorizedUsers)
+	if len(users) == 0 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("db_name", dbName),
+		d.Set("users", users),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS PostgreSQL database privilege fields: %s", err)
+	}
+
+	return nil
+}
+
+func buildPgRevokeUsers(rawUsers []interface{}) []map[string]interface{} {
+	users := make([]map[string]interface{}, len(rawUsers))
+	for i, v := range rawUsers {
+		user := v.(map[string]interface{})
+		users[i] = map[string]interface{}{
+			"name":        user["name"],
+			"schema_name": user["schema_name"],
+		}
+	}
+	return users
+}
+
+func resourcePgDatabasePrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.NewServiceClient("rds", c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	deletePath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/db_privilege"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{instance_id}", instanceId)
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"db_name": d.Get("db_name"),
+			"users":   buildPgRevokeUsers(d.Get("users").(*schema.Set).List()),
+		},
+	}
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.Request("DELETE", deletePath, &deleteOpt)
+		return err
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error revoking RDS PostgreSQL database privilege")
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourcePgSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePgSchemaCreate,
+		DeleteContext: resourcePgSchemaDelete,
+		ReadContext:   resourcePgSchemaRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schema_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePgSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	schemaName := d.Get("schema_name").(string)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	createOpts := rds.CreatePostgresqlDatabaseSchemaRequest{
+		InstanceId: instanceId,
+		Body: &rds.PostgresqlDatabaseSchemaReq{
+			DbName: dbName,
+			Schemas: []rds.PostgresqlCreateSchemaReq{
+				{
+					SchemaName: schemaName,
+					Owner:      d.Get("owner").(string),
+				},
+			},
+		},
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL schema options: %#v", createOpts)
+
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.CreatePostgresqlDatabaseSchema(&createOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL schema: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{instanceId, dbName, schemaName}, "/"))
+	return resourcePgSchemaRead(ctx, d, meta)
+}
+
+func resourcePgSchemaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id, database and schema from resource id
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>/<schema_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+	schemaName := parts[2]
+
+	pgSchema, err := QueryPgSchema(client, instanceId, dbName, schemaName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL schemas")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("db_name", dbName),
+		d.Set("schema_name", pgSchema.SchemaName),
+		d.Set("owner", pgSchema.Owner),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS PostgreSQL schema fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePgSchemaDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting RDS PostgreSQL schema is not supported. The schema is only removed from the state, " +
+		"but it remains in the database."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
+
+func QueryPgSchema(client *v3.RdsClient, instanceId, dbName, schemaName string) (*rds.PostgresqlDatabaseForListSchema,
+	error) {
+	request := rds.ListPostgresqlDatabaseSchemasRequest{
+		InstanceId: instanceId,
+		DbName:     dbName,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	// List all schemas of the database
+	for {
+		response, err := client.ListPostgresqlDatabaseSchemas(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.DatabaseSchemas == nil || len(*response.DatabaseSchemas) == 0 {
+			break
+		}
+
+		schemas := *response.DatabaseSchemas
+		request.Page += 1
+		for _, s := range schemas {
+			if s.SchemaName == schemaName {
+				return &s, nil
+			}
+		}
+	}
+
+	return nil, golangsdk.ErrDefault404{}
+}
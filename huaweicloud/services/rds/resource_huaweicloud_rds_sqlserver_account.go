@@ -0,0 +1,212 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceSQLServerAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSQLServerAccountCreate,
+		UpdateContext: resourceSQLServerAccountUpdate,
+		DeleteContext: resourceSQLServerAccountDelete,
+		ReadContext:   resourceSQLServerAccountRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceSQLServerAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	dbUser := d.Get("name").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	createOpts := &rds.CreateSqlserverDbUserRequest{
+		InstanceId: instanceId,
+		Body: &rds.SqlserverUserForCreation{
+			Name:     dbUser,
+			Password: d.Get("password").(string),
+		},
+	}
+
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.CreateSqlserverDbUser(createOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS SQL Server account: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbUser)
+	return resourceSQLServerAccountRead(ctx, d, meta)
+}
+
+func resourceSQLServerAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	instanceId := parts[0]
+	dbUser := parts[1]
+
+	user, err := QuerySQLServerAccount(client, instanceId, dbUser)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS SQL Server accounts")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("name", user.Name),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS SQL Server account fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSQLServerAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	updateOpts := &rds.SetDbUserPwdRequest{
+		InstanceId: instanceId,
+		Body: &rds.DbUserPwdRequest{
+			Name:     d.Get("name").(string),
+			Password: d.Get("password").(string),
+		},
+	}
+
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+		_, err := client.SetDbUserPwd(updateOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error updating RDS SQL Server account: %s", err)
+	}
+
+	return resourceSQLServerAccountRead(ctx, d, meta)
+}
+
+func resourceSQLServerAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deleteOpts := &rds.DeleteSqlserverDbUserRequest{
+		InstanceId: instanceId,
+		UserName:   d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS SQL Server account options: %#v", deleteOpts)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.DeleteSqlserverDbUser(deleteOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS SQL Server account: %s", err)
+	}
+
+	return nil
+}
+
+func QuerySQLServerAccount(client *v3.RdsClient, instanceId, userName string) (*rds.UserForList, error) {
+	request := rds.ListSqlserverDbUsersRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	// List all accounts
+	for {
+		response, err := client.ListSqlserverDbUsers(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+
+		users := *response.Users
+		request.Page += 1
+		for _, user := range users {
+			if user.Name == userName {
+				return &user, nil
+			}
+		}
+	}
+
+	return nil, golangsdk.ErrDefault404{}
+}
@@ -0,0 +1,186 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceSQLServerDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSQLServerDatabaseCreate,
+		DeleteContext: resourceSQLServerDatabaseDelete,
+		ReadContext:   resourceSQLServerDatabaseRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSQLServerDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	dbName := d.Get("name").(string)
+	createDatabaseReq := rds.CreateSqlserverDatabaseRequest{
+		InstanceId: instanceId,
+		Body: &rds.SqlserverDatabaseForCreation{
+			Name: dbName,
+		},
+	}
+
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.CreateSqlserverDatabase(&createDatabaseReq)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS SQL Server database: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbName)
+	return resourceSQLServerDatabaseRead(ctx, d, meta)
+}
+
+func resourceSQLServerDatabaseRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	db, err := QuerySQLServerDatabase(client, instanceId, dbName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS SQL Server databases")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("name", db.Name),
+		d.Set("character_set", db.CharacterSet),
+		d.Set("status", db.State),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS SQL Server database fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSQLServerDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deleteOpts := rds.DeleteSqlserverDatabaseRequest{
+		InstanceId: instanceId,
+		DbName:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS SQL Server database options: %#v", deleteOpts)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.DeleteSqlserverDatabase(&deleteOpts)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS SQL Server database: %s", err)
+	}
+
+	return nil
+}
+
+func QuerySQLServerDatabase(client *v3.RdsClient, instanceId, dbName string) (*rds.SqlserverDatabaseForDetail, error) {
+	request := rds.ListSqlserverDatabasesRequest{
+		InstanceId: instanceId,
+		DbName:     &dbName,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	// List all databases which match the name
+	for {
+		response, err := client.ListSqlserverDatabases(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Databases == nil || len(*response.Databases) == 0 {
+			break
+		}
+
+		databases := *response.Databases
+		request.Page += 1
+		for _, db := range databases {
+			if db.Name == dbName {
+				return &db, nil
+			}
+		}
+	}
+
+	return nil, golangsdk.ErrDefault404{}
+}
@@ -0,0 +1,226 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceSQLServerDatabasePrivilege() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSQLServerDatabasePrivilegeCreate,
+		DeleteContext: resourceSQLServerDatabasePrivilegeDelete,
+		ReadContext:   resourceSQLServerDatabasePrivilegeRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildSQLServerUserOpts(rawUsers []interface{}, withPrivilege bool) []rds.SqlserverUserWithPrivilege {
+	if len(rawUsers) < 1 {
+		return nil
+	}
+
+	usersOpts := make([]rds.SqlserverUserWithPrivilege, len(rawUsers))
+	for i, v := range rawUsers {
+		user := v.(map[string]interface{})
+		usersOpts[i] = rds.SqlserverUserWithPrivilege{
+			Name: user["name"].(string),
+		}
+		// the readonly is not required when revoking the privilege
+		if withPrivilege {
+			readonly := user["readonly"].(bool)
+			usersOpts[i].Readonly = &readonly
+		}
+	}
+	return usersOpts
+}
+
+func resourceSQLServerDatabasePrivilegeCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	createOpts := rds.SqlserverGrantRequest{
+		DbName: dbName,
+		Users:  buildSQLServerUserOpts(d.Get("users").(*schema.Set).List(), true),
+	}
+	log.Printf("[DEBUG] Create RDS SQL Server database privilege options: %#v", createOpts)
+
+	privilegeReq := rds.AllowSqlserverDbUserPrivilegeRequest{
+		InstanceId: instanceId,
+		Body:       &createOpts,
+	}
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		_, err := client.AllowSqlserverDbUserPrivilege(&privilegeReq)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS SQL Server database privilege: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbName)
+	return resourceSQLServerDatabasePrivilegeRead(ctx, d, meta)
+}
+
+func resourceSQLServerDatabasePrivilegeRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	users, err := QuerySQLServerDatabaseUsers(client, instanceId, dbName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS SQL Server authorized users")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", c.GetRegion(d)),
+		d.Set("instance_id", instanceId),
+		d.Set("db_name", dbName),
+		d.Set("users", flattenUsers(users)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS SQL Server database privilege fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSQLServerDatabasePrivilegeDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := rds.SqlserverRevokeRequest{
+		DbName: d.Get("db_name").(string),
+		Users:  buildSQLServerUserOpts(d.Get("users").(*schema.Set).List(), false),
+	}
+	log.Printf("[DEBUG] Delete RDS SQL Server database privilege options: %#v", opts)
+
+	deleteReq := rds.RevokeSqlserverDbUserPrivilegeRequest{
+		InstanceId: instanceId,
+		Body:       &opts,
+	}
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+	err = retryMultiOperations(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		_, err := client.RevokeSqlserverDbUserPrivilege(&deleteReq)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS SQL Server database privilege: %s", err)
+	}
+
+	return nil
+}
+
+func QuerySQLServerDatabaseUsers(client *v3.RdsClient, instanceId, dbName string) ([]rds.UserWithPrivilege, error) {
+	request := rds.ListAuthorizedSqlserverDbUsersRequest{
+		InstanceId: instanceId,
+		DbName:     dbName,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	// List all authorized users of the database
+	allUsers := []rds.UserWithPrivilege{}
+	for {
+		response, err := client.ListAuthorizedSqlserverDbUsers(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+
+		allUsers = append(allUsers, *response.Users...)
+		request.Page += 1
+	}
+
+	if len(allUsers) < 1 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+
+	return allUsers, nil
+}
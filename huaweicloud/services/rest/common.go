@@ -0,0 +1,291 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// deletedStatus is the status reported by the polling request when the API returns 404.
+const deletedStatus = "deleted"
+
+// restResponse is the result of a REST API call.
+type restResponse struct {
+	StatusCode int
+	// the raw response body
+	Raw string
+	// the JSON decoded response body, nil if the body is empty or not a JSON document
+	Body interface{}
+}
+
+func jsonBodySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsJSON,
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			return utils.JSONStringsEqual(old, new)
+		},
+	}
+}
+
+func waitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"status_expression": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"target": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"pending": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"delay": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"interval": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// callSchema returns the schema of a REST API call definition.
+func callSchema(defaultMethod string, withWait bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"path": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      defaultMethod,
+			ValidateFunc: validation.StringInSlice([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}, false),
+		},
+		"query": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"headers": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"ok_codes": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+	}
+	if defaultMethod != "GET" {
+		s["body"] = jsonBodySchema()
+	}
+	if withWait {
+		s["wait"] = waitSchema()
+	}
+	return s
+}
+
+// buildRequestURL replaces the placeholders of the path template and appends the query parameters.
+// The supported placeholders are {project_id}, {domain_id}, {region} and {id}.
+func buildRequestURL(cfg *config.Config, client *golangsdk.ServiceClient, region, path, id string,
+	query map[string]interface{}) string {
+	replacer := strings.NewReplacer(
+		"{project_id}", client.ProjectID,
+		"{domain_id}", cfg.DomainID,
+		"{region}", region,
+		"{id}", id,
+	)
+	requestURL := client.Endpoint + strings.TrimPrefix(replacer.Replace(path), "/")
+
+	if len(query) > 0 {
+		values := url.Values{}
+		for k, v := range query {
+			values.Set(k, replacer.Replace(v.(string)))
+		}
+		requestURL += "?" + values.Encode()
+	}
+	return requestURL
+}
+
+// doRequest sends the request and decodes the JSON response body.
+func doRequest(client *golangsdk.ServiceClient, method, requestURL, body string, headers map[string]interface{},
+	okCodes []interface{}) (*restResponse, error) {
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      make(map[string]string),
+	}
+	for k, v := range headers {
+		opts.MoreHeaders[k] = v.(string)
+	}
+	for _, code := range okCodes {
+		opts.OkCodes = append(opts.OkCodes, code.(int))
+	}
+	if body != "" {
+		var jsonBody interface{}
+		if err := json.Unmarshal([]byte(body), &jsonBody); err != nil {
+			return nil, fmt.Errorf("error parsing the request body: %s", err)
+		}
+		opts.JSONBody = jsonBody
+	}
+
+	resp, err := client.Request(method, requestURL, &opts)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the response body: %s", err)
+	}
+
+	result := restResponse{
+		StatusCode: resp.StatusCode,
+		Raw:        string(raw),
+	}
+	if len(raw) > 0 {
+		var respBody interface{}
+		if json.Unmarshal(raw, &respBody) == nil {
+			result.Body = respBody
+		}
+	}
+	return &result, nil
+}
+
+// doCall sends the request which is defined by the call block.
+func doCall(cfg *config.Config, client *golangsdk.ServiceClient, region, id string,
+	call map[string]interface{}) (*restResponse, error) {
+	requestURL := buildRequestURL(cfg, client, region, call["path"].(string), id,
+		call["query"].(map[string]interface{}))
+	body, _ := call["body"].(string)
+	okCodes, _ := call["ok_codes"].([]interface{})
+
+	return doRequest(client, call["method"].(string), requestURL, body, call["headers"].(map[string]interface{}),
+		okCodes)
+}
+
+// evaluateOutput evaluates the JMESPath expression against the response body and returns the result as a JSON string.
+func evaluateOutput(expression string, body interface{}) (string, error) {
+	if expression == "" || body == nil {
+		return "", nil
+	}
+
+	result := utils.PathSearch(expression, body, nil)
+	if result == nil {
+		return "", nil
+	}
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling the output: %s", err)
+	}
+	return string(output), nil
+}
+
+func statusRefreshFunc(cfg *config.Config, client *golangsdk.ServiceClient, region, id, path string,
+	call map[string]interface{}, expression string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		requestURL := buildRequestURL(cfg, client, region, path, id, nil)
+		headers, _ := call["headers"].(map[string]interface{})
+		resp, err := doRequest(client, "GET", requestURL, "", headers, nil)
+		if err != nil {
+			if isStatusNotFound(err) {
+				return "", deletedStatus, nil
+			}
+			return nil, "ERROR", err
+		}
+
+		status := utils.PathSearch(expression, resp.Body, nil)
+		if status == nil {
+			return resp, "", nil
+		}
+		return resp, fmt.Sprint(status), nil
+	}
+}
+
+// waitForCall waits for the status of the resource to become one of the target values if the wait block is defined,
+// the status is polled by a GET request to the path of the wait block, and defaults to the path of the read call.
+func waitForCall(ctx context.Context, cfg *config.Config, client *golangsdk.ServiceClient, region, id string,
+	call map[string]interface{}, readPath string, timeout time.Duration) error {
+	waits, _ := call["wait"].([]interface{})
+	if len(waits) == 0 || waits[0] == nil {
+		return nil
+	}
+
+	wait := waits[0].(map[string]interface{})
+	path := wait["path"].(string)
+	if path == "" {
+		path = readPath
+	}
+	if path == "" {
+		return fmt.Errorf("the path of the wait block is required when the read call is not defined")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      utils.ExpandToStringList(wait["pending"].([]interface{})),
+		Target:       utils.ExpandToStringList(wait["target"].([]interface{})),
+		Refresh:      statusRefreshFunc(cfg, client, region, id, path, call, wait["status_expression"].(string)),
+		Timeout:      timeout,
+		Delay:        time.Duration(wait["delay"].(int)) * time.Second,
+		PollInterval: time.Duration(wait["interval"].(int)) * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// getCall returns the call definition of the block, or nil if the block is not defined.
+func getCall(d *schema.ResourceData, key string) map[string]interface{} {
+	calls := d.Get(key).([]interface{})
+	if len(calls) == 0 || calls[0] == nil {
+		return nil
+	}
+	return calls[0].(map[string]interface{})
+}
+
+// isStatusNotFound checks whether the error is a 404 response.
+func isStatusNotFound(err error) bool {
+	if _, ok := err.(golangsdk.ErrDefault404); ok {
+		return true
+	}
+	if errCode, ok := err.(golangsdk.ErrUnexpectedResponseCode); ok {
+		return errCode.Actual == http.StatusNotFound
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+)
+
+// DataSourceRestAPI sends a signed GET request to any API of the service catalog, which is used to access the APIs
+// that are not yet supported by the dedicated data sources. The data source is read on every plan and refresh, so the
+// mutating methods are only supported by the resource.
+func DataSourceRestAPI() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRestAPIRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The service catalog key used to resolve the endpoint, e.g. vpc or ecs.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path template of the API, relative to the service endpoint.",
+			},
+			"query": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The query parameters of the request.",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The additional headers of the request.",
+			},
+			"ok_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The expected status codes of the response.",
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The JMESPath expression evaluated against the response body.",
+			},
+			"status_code": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The status code of the response.",
+			},
+			"response": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The raw response body.",
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The JSON encoded result of the output expression.",
+			},
+		},
+	}
+}
+
+func dataSourceRestAPIRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	service := d.Get("service").(string)
+	client, err := cfg.NewServiceClient(service, region)
+	if err != nil {
+		return diag.Errorf("error creating %s client: %s", service, err)
+	}
+
+	requestURL := buildRequestURL(cfg, client, region, d.Get("path").(string), "",
+		d.Get("query").(map[string]interface{}))
+	resp, err := doRequest(client, "GET", requestURL, "", d.Get("headers").(map[string]interface{}),
+		d.Get("ok_codes").([]interface{}))
+	if err != nil {
+		return diag.Errorf("error sending request (GET %s): %s", requestURL, err)
+	}
+
+	result, err := evaluateOutput(d.Get("output").(string), resp.Body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(hashcode.Strings([]string{"GET", requestURL}))
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("status_code", resp.StatusCode),
+		d.Set("response", resp.Raw),
+		d.Set("result", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,247 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRestAPI manages a resource by the signed requests which are defined by the create, read, update and delete
+// call blocks, which is used to manage the resources that are not yet supported by the dedicated resources.
+func ResourceRestAPI() *schema.Resource {
+	createSchema := callSchema("POST", true)
+	createSchema["id_expression"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The JMESPath expression used to extract the resource ID from the create response.",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceRestAPICreate,
+		ReadContext:   resourceRestAPIRead,
+		UpdateContext: resourceRestAPIUpdate,
+		DeleteContext: resourceRestAPIDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The service catalog key used to resolve the endpoint, e.g. vpc or ecs.",
+			},
+			"create": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem:        &schema.Resource{Schema: createSchema},
+				Description: "The API call used to create the resource.",
+			},
+			"read": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        &schema.Resource{Schema: callSchema("GET", false)},
+				Description: "The API call used to query the resource.",
+			},
+			"update": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        &schema.Resource{Schema: callSchema("PUT", true)},
+				Description: "The API call used to update the resource.",
+			},
+			"delete": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        &schema.Resource{Schema: callSchema("DELETE", true)},
+				Description: "The API call used to delete the resource.",
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The JMESPath expression evaluated against the response body of the read call.",
+			},
+			"response": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The raw response body of the read call, or the create call if the read call is absent.",
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The JSON encoded result of the output expression.",
+			},
+		},
+	}
+}
+
+func getReadPath(d *schema.ResourceData) string {
+	if readCall := getCall(d, "read"); readCall != nil {
+		return readCall["path"].(string)
+	}
+	return ""
+}
+
+func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	service := d.Get("service").(string)
+	client, err := cfg.NewServiceClient(service, region)
+	if err != nil {
+		return diag.Errorf("error creating %s client: %s", service, err)
+	}
+
+	createCall := getCall(d, "create")
+	resp, err := doCall(cfg, client, region, "", createCall)
+	if err != nil {
+		return diag.Errorf("error creating REST API resource: %s", err)
+	}
+
+	idExpression := createCall["id_expression"].(string)
+	id := utils.PathSearch(idExpression, resp.Body, nil)
+	if id == nil {
+		return diag.Errorf("error creating REST API resource: unable to find the ID by the expression (%s) "+
+			"in the response: %s", idExpression, resp.Raw)
+	}
+	d.SetId(fmt.Sprint(id))
+
+	err = waitForCall(ctx, cfg, client, region, d.Id(), createCall, getReadPath(d), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the REST API resource (%s) to be created: %s", d.Id(), err)
+	}
+
+	if getCall(d, "read") == nil {
+		return setRestAPIResponse(d, region, resp)
+	}
+	return resourceRestAPIRead(ctx, d, meta)
+}
+
+func setRestAPIResponse(d *schema.ResourceData, region string, resp *restResponse) diag.Diagnostics {
+	result, err := evaluateOutput(d.Get("output").(string), resp.Body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("response", resp.Raw),
+		d.Set("result", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceRestAPIRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	readCall := getCall(d, "read")
+	if readCall == nil {
+		// the resource can not be refreshed without the read call, keep the response saved by the create call
+		log.Printf("[DEBUG] the read call of the REST API resource (%s) is not defined, skip refreshing", d.Id())
+		return nil
+	}
+
+	service := d.Get("service").(string)
+	client, err := cfg.NewServiceClient(service, region)
+	if err != nil {
+		return diag.Errorf("error creating %s client: %s", service, err)
+	}
+
+	resp, err := doCall(cfg, client, region, d.Id(), readCall)
+	if err != nil {
+		if isStatusNotFound(err) {
+			err = golangsdk.ErrDefault404{}
+		}
+		return common.CheckDeletedDiag(d, err, "error retrieving REST API resource")
+	}
+
+	return setRestAPIResponse(d, region, resp)
+}
+
+func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	updateCall := getCall(d, "update")
+	if d.HasChange("update") && updateCall != nil {
+		service := d.Get("service").(string)
+		client, err := cfg.NewServiceClient(service, region)
+		if err != nil {
+			return diag.Errorf("error creating %s client: %s", service, err)
+		}
+
+		if _, err := doCall(cfg, client, region, d.Id(), updateCall); err != nil {
+			return diag.Errorf("error updating REST API resource (%s): %s", d.Id(), err)
+		}
+
+		err = waitForCall(ctx, cfg, client, region, d.Id(), updateCall, getReadPath(d), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for the REST API resource (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRestAPIRead(ctx, d, meta)
+}
+
+func resourceRestAPIDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	deleteCall := getCall(d, "delete")
+	if deleteCall == nil {
+		errorMsg := "The delete call of the REST API resource is not defined. The resource is only removed " +
+			"from the state, but it remains in the cloud."
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  errorMsg,
+			},
+		}
+	}
+
+	service := d.Get("service").(string)
+	client, err := cfg.NewServiceClient(service, region)
+	if err != nil {
+		return diag.Errorf("error creating %s client: %s", service, err)
+	}
+
+	if _, err := doCall(cfg, client, region, d.Id(), deleteCall); err != nil {
+		if isStatusNotFound(err) {
+			err = golangsdk.ErrDefault404{}
+		}
+		return common.CheckDeletedDiag(d, err, "error deleting REST API resource")
+	}
+
+	err = waitForCall(ctx, cfg, client, region, d.Id(), deleteCall, getReadPath(d), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the REST API resource (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+package vpn
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the VPN connections are located.`,
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The VPN connection ID used to query specified connection.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The name used to filter the VPN connections.`,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The VPN gateway ID used to filter the VPN connections.`,
+			},
+			"gateway_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The ID of the VPN gateway EIP used to filter the VPN connections.`,
+			},
+			"customer_gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The customer gateway ID used to filter the VPN connections.`,
+			},
+			"vpn_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The connection type used to filter the VPN connections.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The tunnel status used to filter the VPN connections.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The enterprise project ID used to filter the VPN connections.`,
+			},
+			// Attributes
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The VPN connection ID.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the VPN connection.`,
+						},
+						"gateway_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The VPN gateway ID.`,
+						},
+						"gateway_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the VPN gateway EIP.`,
+						},
+						"customer_gateway_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The customer gateway ID.`,
+						},
+						"vpn_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The connection type.`,
+						},
+						"peer_subnets": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The CIDR list of customer subnets.`,
+						},
+						"tunnel_local_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The local tunnel address.`,
+						},
+						"tunnel_peer_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The peer tunnel address.`,
+						},
+						"enable_nqa": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether NQA check is enabled.`,
+						},
+						"ha_role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The HA role of the VPN connection.`,
+						},
+						"connection_monitor_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the connection health check.`,
+						},
+						"ikepolicy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ike_version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The IKE negotiation version.`,
+									},
+									"authentication_method": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The authentication method used during IKE negotiation.`,
+									},
+									"authentication_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The authentication algorithm.`,
+									},
+									"encryption_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The encryption algorithm.`,
+									},
+									"pfs": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The DH key group used by PFS.`,
+									},
+									"lifetime_seconds": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `The life cycle of SA in seconds.`,
+									},
+								},
+							},
+							Description: `The IKE policy configurations.`,
+						},
+						"ipsecpolicy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The authentication algorithm.`,
+									},
+									"encryption_algorithm": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The encryption algorithm.`,
+									},
+									"pfs": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The DH key group used by PFS.`,
+									},
+									"lifetime_seconds": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `The life cycle of SA in seconds.`,
+									},
+									"transform_protocol": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The transform protocol.`,
+									},
+									"encapsulation_mode": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The encapsulation mode.`,
+									},
+								},
+							},
+							Description: `The IPsec policy configurations.`,
+						},
+						"enterprise_project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The enterprise project ID.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The tunnel status of the VPN connection.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The create time.`,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The update time.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenConnectionIkePolicy(policy interface{}) []interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ike_version":              utils.PathSearch("ike_version", policy, nil),
+			"authentication_method":    utils.PathSearch("authentication_method", policy, nil),
+			"authentication_algorithm": utils.PathSearch("authentication_algorithm", policy, nil),
+			"encryption_algorithm":     utils.PathSearch("encryption_algorithm", policy, nil),
+			"pfs":                      utils.PathSearch("pfs", policy, nil),
+			"lifetime_seconds":         utils.PathSearch("lifetime_seconds", policy, nil),
+		},
+	}
+}
+
+func flattenConnectionIpsecPolicy(policy interface{}) []interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"authentication_algorithm": utils.PathSearch("authentication_algorithm", policy, nil),
+			"encryption_algorithm":     utils.PathSearch("encryption_algorithm", policy, nil),
+			"pfs":                      utils.PathSearch("pfs", policy, nil),
+			"lifetime_seconds":         utils.PathSearch("lifetime_seconds", policy, nil),
+			"transform_protocol":       utils.PathSearch("transform_protocol", policy, nil),
+			"encapsulation_mode":       utils.PathSearch("encapsulation_mode", policy, nil),
+		},
+	}
+}
+
+func flattenConnections(d *schema.ResourceData, all []interface{}) []map[string]interface{} {
+	filters := map[string]string{
+		"id":     d.Get("connection_id").(string),
+		"name":   d.Get("name").(string),
+		"cgw_id": d.Get("customer_gateway_id").(string),
+		"style":  d.Get("vpn_type").(string),
+		"status": d.Get("status").(string),
+	}
+
+	result := make([]map[string]interface{}, 0, len(all))
+	for _, connection := range all {
+		if !utils.IsFieldsMatched(connection, filters) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    utils.PathSearch("id", connection, nil),
+			"name":                  utils.PathSearch("name", connection, nil),
+			"gateway_id":            utils.PathSearch("vgw_id", connection, nil),
+			"gateway_ip":            utils.PathSearch("vgw_ip", connection, nil),
+			"customer_gateway_id":   utils.PathSearch("cgw_id", connection, nil),
+			"vpn_type":              utils.PathSearch("style", connection, nil),
+			"peer_subnets":          utils.PathSearch("peer_subnets", connection, nil),
+			"tunnel_local_address":  utils.PathSearch("tunnel_local_address", connection, nil),
+			"tunnel_peer_address":   utils.PathSearch("tunnel_peer_address", connection, nil),
+			"enable_nqa":            utils.PathSearch("enable_nqa", connection, false),
+			"ha_role":               utils.PathSearch("ha_role", connection, nil),
+			"connection_monitor_id": utils.PathSearch("connection_monitor_id", connection, nil),
+			"ikepolicy":             flattenConnectionIkePolicy(utils.PathSearch("ikepolicy", connection, nil)),
+			"ipsecpolicy":           flattenConnectionIpsecPolicy(utils.PathSearch("ipsecpolicy", connection, nil)),
+			"enterprise_project_id": utils.PathSearch("enterprise_project_id", connection, nil),
+			"status":                utils.PathSearch("status", connection, nil),
+			"created_at":            utils.PathSearch("created_at", connection, nil),
+			"updated_at":            utils.PathSearch("updated_at", connection, nil),
+		})
+	}
+	return result
+}
+
+func dataSourceConnectionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	queryParams := utils.BuildQueryParams(map[string]interface{}{
+		"vgw_id":                d.Get("gateway_id"),
+		"vgw_ip":                d.Get("gateway_ip"),
+		"enterprise_project_id": d.Get("enterprise_project_id"),
+	})
+	path := client.Endpoint + "v5/{project_id}/vpn-connection?limit=100" + queryParams
+	connections, err := utils.ListWithMarker(client, path, "vpn_connections")
+	if err != nil {
+		return diag.Errorf("error retrieving VPN connections: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("connections", flattenConnections(d, connections)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving VPN connection list fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceGatewayAvailabilityZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGatewayAvailabilityZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region in which to query the availability zones.`,
+			},
+			"flavor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The flavor of the VPN gateway.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Basic", "Professional1", "Professional2",
+				}, false),
+			},
+			"attachment_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "vpc",
+				Description: `The attachment type of the VPN gateway.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "er",
+				}, false),
+			},
+			// Attributes
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The names of the availability zones which support the VPN gateway.`,
+			},
+		},
+	}
+}
+
+func dataSourceGatewayAvailabilityZonesRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	flavor := d.Get("flavor").(string)
+	attachmentType := d.Get("attachment_type").(string)
+	path := client.Endpoint + "v5/{project_id}/vpn-gateways/availability-zones?flavor={flavor}" +
+		"&attachment_type={attachment_type}"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{flavor}", flavor)
+	path = strings.ReplaceAll(path, "{attachment_type}", attachmentType)
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opt)
+	if err != nil {
+		return diag.Errorf("error retrieving VPN gateway availability zones: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The availability zones are grouped by the flavor (in lowercase) and the attachment type.
+	expression := fmt.Sprintf("availability_zones.%s.%s", strings.ToLower(flavor), attachmentType)
+	names := utils.PathSearch(expression, respBody, make([]interface{}, 0))
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("names", names),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving VPN gateway availability zones: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+package vpn
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the VPN gateways are located.`,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The VPN gateway ID used to query specified gateway.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The name used to filter the VPN gateways.`,
+			},
+			"flavor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The flavor used to filter the VPN gateways.`,
+			},
+			"attachment_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The attachment type used to filter the VPN gateways.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The status used to filter the VPN gateways.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The enterprise project ID used to filter the VPN gateways.`,
+			},
+			// Attributes
+			"gateways": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The VPN gateway ID.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the VPN gateway.`,
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the VPC to which the VPN gateway is connected.`,
+						},
+						"local_subnets": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The local subnets.`,
+						},
+						"connect_subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Network ID of the VPC subnet used by the VPN gateway.`,
+						},
+						"availability_zones": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The availability zone IDs.`,
+						},
+						"flavor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The flavor of the VPN gateway.`,
+						},
+						"attachment_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The attachment type.`,
+						},
+						"network_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The network type of the VPN gateway.`,
+						},
+						"asn": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The ASN number of BGP.`,
+						},
+						"certificate_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the gateway certificate.`,
+						},
+						"master_eip": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        dataSourceGatewayEipSchema(),
+							Description: `The master EIP of the VPN gateway.`,
+						},
+						"slave_eip": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        dataSourceGatewayEipSchema(),
+							Description: `The slave EIP of the VPN gateway.`,
+						},
+						"used_connection_group": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of used connection groups.`,
+						},
+						"used_connection_number": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of used connections.`,
+						},
+						"enterprise_project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The enterprise project ID.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The status of VPN gateway.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The create time.`,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The update time.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGatewayEipSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The public IP ID.`,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The public IP address.`,
+			},
+			"ip_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The public IP version.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The EIP type.`,
+			},
+			"bandwidth_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The bandwidth ID.`,
+			},
+			"bandwidth_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The bandwidth name.`,
+			},
+			"bandwidth_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Bandwidth size in Mbit/s.`,
+			},
+			"charge_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The charge mode of the bandwidth.`,
+			},
+		},
+	}
+}
+
+func flattenGatewayEip(eip interface{}) []interface{} {
+	if eip == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":             utils.PathSearch("id", eip, nil),
+			"ip_address":     utils.PathSearch("ip_address", eip, nil),
+			"ip_version":     utils.PathSearch("ip_version", eip, nil),
+			"type":           utils.PathSearch("type", eip, nil),
+			"bandwidth_id":   utils.PathSearch("bandwidth_id", eip, nil),
+			"bandwidth_name": utils.PathSearch("bandwidth_name", eip, nil),
+			"bandwidth_size": utils.PathSearch("bandwidth_size", eip, nil),
+			"charge_mode":    utils.PathSearch("charge_mode", eip, nil),
+		},
+	}
+}
+
+func flattenGateways(d *schema.ResourceData, all []interface{}) []map[string]interface{} {
+	filters := map[string]string{
+		"id":     d.Get("gateway_id").(string),
+		"name":   d.Get("name").(string),
+		"flavor": d.Get("flavor").(string),
+		"status": d.Get("status").(string),
+	}
+
+	result := make([]map[string]interface{}, 0, len(all))
+	for _, gateway := range all {
+		if !utils.IsFieldsMatched(gateway, filters) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                     utils.PathSearch("id", gateway, nil),
+			"name":                   utils.PathSearch("name", gateway, nil),
+			"vpc_id":                 utils.PathSearch("vpc_id", gateway, nil),
+			"local_subnets":          utils.PathSearch("local_subnets", gateway, nil),
+			"connect_subnet":         utils.PathSearch("connect_subnet", gateway, nil),
+			"availability_zones":     utils.PathSearch("availability_zone_ids", gateway, nil),
+			"flavor":                 utils.PathSearch("flavor", gateway, nil),
+			"attachment_type":        utils.PathSearch("attachment_type", gateway, nil),
+			"network_type":           utils.PathSearch("network_type", gateway, nil),
+			"asn":                    utils.PathSearch("bgp_asn", gateway, nil),
+			"certificate_id":         utils.PathSearch("certificate_id", gateway, nil),
+			"master_eip":             flattenGatewayEip(utils.PathSearch("master_eip", gateway, nil)),
+			"slave_eip":              flattenGatewayEip(utils.PathSearch("slave_eip", gateway, nil)),
+			"used_connection_group":  utils.PathSearch("used_connection_group", gateway, nil),
+			"used_connection_number": utils.PathSearch("used_connection_number", gateway, nil),
+			"enterprise_project_id":  utils.PathSearch("enterprise_project_id", gateway, nil),
+			"status":                 utils.PathSearch("status", gateway, nil),
+			"created_at":             utils.PathSearch("created_at", gateway, nil),
+			"updated_at":             utils.PathSearch("updated_at", gateway, nil),
+		})
+	}
+	return result
+}
+
+func dataSourceGatewaysRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	queryParams := utils.BuildQueryParams(map[string]interface{}{
+		"attachment_type":       d.Get("attachment_type"),
+		"enterprise_project_id": d.Get("enterprise_project_id"),
+	})
+	path := client.Endpoint + "v5/{project_id}/vpn-gateways?limit=100" + queryParams
+	gateways, err := utils.ListWithMarker(client, path, "vpn_gateways")
+	if err != nil {
+		return diag.Errorf("error retrieving VPN gateways: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateways", flattenGateways(d, gateways)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving VPN gateway list fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/jmespath/go-jmespath"
+)
+
+func ResourceGatewayCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGatewayCertificateCreate,
+		UpdateContext: resourceGatewayCertificateUpdate,
+		ReadContext:   resourceGatewayCertificateRead,
+		DeleteContext: resourceGatewayCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGatewayCertificateImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpn_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the VPN gateway to which the certificate belongs.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The name of the gateway certificate.`,
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The content of the signing certificate.`,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: `The private key of the signing certificate.`,
+			},
+			"certificate_chain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The certificate chain of the CA which issued the certificates.`,
+			},
+			"enc_certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The content of the encryption certificate.`,
+			},
+			"enc_private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: `The private key of the encryption certificate.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the gateway certificate.`,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The issuer of the signing certificate.`,
+			},
+			"signature_algorithm": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The signature algorithm of the signing certificate.`,
+			},
+			"certificate_serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The serial number of the signing certificate.`,
+			},
+			"certificate_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The subject of the signing certificate.`,
+			},
+			"certificate_expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The expiration time of the signing certificate.`,
+			},
+			"certificate_chain_serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The serial number of the certificate chain.`,
+			},
+			"certificate_chain_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The subject of the certificate chain.`,
+			},
+			"certificate_chain_expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The expiration time of the certificate chain.`,
+			},
+			"enc_certificate_serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The serial number of the encryption certificate.`,
+			},
+			"enc_certificate_subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The subject of the encryption certificate.`,
+			},
+			"enc_certificate_expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The expiration time of the encryption certificate.`,
+			},
+			"enc_certificate_issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The issuer of the encryption certificate.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The create time.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The update time.`,
+			},
+		},
+	}
+}
+
+func resourceGatewayCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createGatewayCertificate: Upload the certificate of the VPN gateway.
+	var (
+		createGatewayCertificateHttpUrl = "v5/{project_id}/vpn-gateways/{vgw_id}/certificate"
+		createGatewayCertificateProduct = "vpn"
+	)
+	createGatewayCertificateClient, err := cfg.NewServiceClient(createGatewayCertificateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating VPN Client: %s", err)
+	}
+
+	createGatewayCertificatePath := createGatewayCertificateClient.Endpoint + createGatewayCertificateHttpUrl
+	createGatewayCertificatePath = strings.ReplaceAll(createGatewayCertificatePath, "{project_id}",
+		createGatewayCertificateClient.ProjectID)
+	createGatewayCertificatePath = strings.ReplaceAll(createGatewayCertificatePath, "{vgw_id}",
+		d.Get("vpn_gateway_id").(string))
+
+	createGatewayCertificateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+	}
+	createGatewayCertificateOpt.JSONBody = utils.RemoveNil(buildGatewayCertificateBodyParams(d))
+	createGatewayCertificateResp, err := createGatewayCertificateClient.Request("POST",
+		createGatewayCertificatePath, &createGatewayCertificateOpt)
+	if err != nil {
+		return diag.Errorf("error creating GatewayCertificate: %s", err)
+	}
+
+	createGatewayCertificateRespBody, err := utils.FlattenResponse(createGatewayCertificateResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := jmespath.Search("certificate.id", createGatewayCertificateRespBody)
+	if err != nil || id == nil {
+		return diag.Errorf("error creating GatewayCertificate: ID is not found in API response")
+	}
+	d.SetId(id.(string))
+
+	return resourceGatewayCertificateRead(ctx, d, meta)
+}
+
+func buildGatewayCertificateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"certificate": map[string]interface{}{
+			"name":              utils.ValueIngoreEmpty(d.Get("name")),
+			"certificate":       d.Get("certificate"),
+			"private_key":       d.Get("private_key"),
+			"certificate_chain": d.Get("certificate_chain"),
+			"enc_certificate":   d.Get("enc_certificate"),
+			"enc_private_key":   d.Get("enc_private_key"),
+		},
+	}
+	return bodyParams
+}
+
+func resourceGatewayCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getGatewayCertificate: Query the certificate of the VPN gateway, each gateway has at most one certificate.
+	var (
+		getGatewayCertificateHttpUrl = "v5/{project_id}/vpn-gateways/{vgw_id}/certificate"
+		getGatewayCertificateProduct = "vpn"
+	)
+	getGatewayCertificateClient, err := cfg.NewServiceClient(getGatewayCertificateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating VPN Client: %s", err)
+	}
+
+	getGatewayCertificatePath := getGatewayCertificateClient.Endpoint + getGatewayCertificateHttpUrl
+	getGatewayCertificatePath = strings.ReplaceAll(getGatewayCertificatePath, "{project_id}",
+		getGatewayCertificateClient.ProjectID)
+	getGatewayCertificatePath = strings.ReplaceAll(getGatewayCertificatePath, "{vgw_id}",
+		d.Get("vpn_gateway_id").(string))
+
+	getGatewayCertificateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getGatewayCertificateResp, err := getGatewayCertificateClient.Request("GET", getGatewayCertificatePath,
+		&getGatewayCertificateOpt)
+
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GatewayCertificate")
+	}
+
+	getGatewayCertificateRespBody, err := utils.FlattenResponse(getGatewayCertificateResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The certificate has been replaced by another one outside of Terraform.
+	certificate := utils.PathSearch("certificate", getGatewayCertificateRespBody, nil)
+	if utils.PathSearch("id", certificate, "").(string) != d.Id() {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving GatewayCertificate")
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("vpn_gateway_id", utils.PathSearch("vgw_id", certificate, nil)),
+		d.Set("name", utils.PathSearch("name", certificate, nil)),
+		d.Set("status", utils.PathSearch("status", certificate, nil)),
+		d.Set("issuer", utils.PathSearch("issuer", certificate, nil)),
+		d.Set("signature_algorithm", utils.PathSearch("signature_algorithm", certificate, nil)),
+		d.Set("certificate_serial_number", utils.PathSearch("certificate_serial_number", certificate, nil)),
+		d.Set("certificate_subject", utils.PathSearch("certificate_subject", certificate, nil)),
+		d.Set("certificate_expire_time", utils.PathSearch("certificate_expire_time", certificate, nil)),
+		d.Set("certificate_chain_serial_number",
+			utils.PathSearch("certificate_chain_serial_number", certificate, nil)),
+		d.Set("certificate_chain_subject", utils.PathSearch("certificate_chain_subject", certificate, nil)),
+		d.Set("certificate_chain_expire_time", utils.PathSearch("certificate_chain_expire_time", certificate, nil)),
+		d.Set("enc_certificate_serial_number", utils.PathSearch("enc_certificate_serial_number", certificate, nil)),
+		d.Set("enc_certificate_subject", utils.PathSearch("enc_certificate_subject", certificate, nil)),
+		d.Set("enc_certificate_expire_time", utils.PathSearch("enc_certificate_expire_time", certificate, nil)),
+		d.Set("enc_certificate_issuer", utils.PathSearch("enc_certificate_issuer", certificate, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", certificate, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", certificate, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceGatewayCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	updateGatewayCertificatehasChanges := []string{
+		"name",
+		"certificate",
+		"private_key",
+		"certificate_chain",
+		"enc_certificate",
+		"enc_private_key",
+	}
+
+	if d.HasChanges(updateGatewayCertificatehasChanges...) {
+		// updateGatewayCertificate: Update the certificate of the VPN gateway, the certificate contents can be
+		// replaced without changing the certificate ID.
+		var (
+			updateGatewayCertificateHttpUrl = "v5/{project_id}/vpn-gateways/{vgw_id}/certificate/{id}"
+			updateGatewayCertificateProduct = "vpn"
+		)
+		updateGatewayCertificateClient, err := cfg.NewServiceClient(updateGatewayCertificateProduct, region)
+		if err != nil {
+			return diag.Errorf("error creating VPN Client: %s", err)
+		}
+
+		updateGatewayCertificatePath := updateGatewayCertificateClient.Endpoint + updateGatewayCertificateHttpUrl
+		updateGatewayCertificatePath = strings.ReplaceAll(updateGatewayCertificatePath, "{project_id}",
+			updateGatewayCertificateClient.ProjectID)
+		updateGatewayCertificatePath = strings.ReplaceAll(updateGatewayCertificatePath, "{vgw_id}",
+			d.Get("vpn_gateway_id").(string))
+		updateGatewayCertificatePath = strings.ReplaceAll(updateGatewayCertificatePath, "{id}", d.Id())
+
+		updateGatewayCertificateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+		}
+		updateGatewayCertificateOpt.JSONBody = utils.RemoveNil(buildGatewayCertificateBodyParams(d))
+		_, err = updateGatewayCertificateClient.Request("PUT", updateGatewayCertificatePath,
+			&updateGatewayCertificateOpt)
+		if err != nil {
+			return diag.Errorf("error updating GatewayCertificate: %s", err)
+		}
+	}
+	return resourceGatewayCertificateRead(ctx, d, meta)
+}
+
+func resourceGatewayCertificateDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting VPN gateway certificate is not supported. The certificate is only removed from the " +
+		"state, but it remains in the cloud."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
+
+func resourceGatewayCertificateImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<vpn_gateway_id>/<id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("vpn_gateway_id", parts[0])
+}
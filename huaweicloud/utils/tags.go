@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/common/tags"
@@ -11,6 +12,34 @@ import (
 
 const SysTagKeyEnterpriseProjectId = "_sys_enterprise_project_id"
 
+// MergeDefaultTags returns a new map which contains the provider-level default tags and the given tags,
+// the value of the given tags takes precedence over the default one if both have the same key.
+func MergeDefaultTags(defaultTags, tagmap map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tagmap))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tagmap {
+		result[k] = v
+	}
+	return result
+}
+
+// IsIgnoredTagKey checks whether the tag key matches any of the ignored keys or key prefixes.
+func IsIgnoredTagKey(key string, ignoreKeys, ignoreKeyPrefixes []string) bool {
+	for _, k := range ignoreKeys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range ignoreKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // CreateResourceTags is a helper to create the tags for a resource.
 // It expects the schema name must be "tags"
 func CreateResourceTags(client *golangsdk.ServiceClient, d *schema.ResourceData, resourceType, id string) error {
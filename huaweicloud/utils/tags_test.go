@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAccFunction_mergeDefaultTags(t *testing.T) {
+	var (
+		defaultTags = map[string]interface{}{
+			"owner":       "platform",
+			"cost_center": "cc-001",
+		}
+		testInput = map[string]interface{}{
+			"owner": "network",
+			"foo":   "bar",
+		}
+		expected = map[string]interface{}{
+			"owner":       "network",
+			"cost_center": "cc-001",
+			"foo":         "bar",
+		}
+	)
+
+	result := MergeDefaultTags(defaultTags, testInput)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("The processing result of the function 'MergeDefaultTags' is not as expected, want %s, but got %s",
+			green(expected), yellow(result))
+	}
+	t.Logf("The processing result of function 'MergeDefaultTags' meets expectation: %s", green(expected))
+}
+
+func TestAccFunction_isIgnoredTagKey(t *testing.T) {
+	var (
+		ignoreKeys        = []string{"owner"}
+		ignoreKeyPrefixes = []string{"_sys_", "auto:"}
+		testInput         = map[string]bool{
+			"owner":        true,
+			"_sys_scanner": true,
+			"auto:backup":  true,
+			"foo":          false,
+			"owner_team":   false,
+		}
+	)
+
+	for key, expected := range testInput {
+		result := IsIgnoredTagKey(key, ignoreKeys, ignoreKeyPrefixes)
+		if result != expected {
+			t.Fatalf("The processing result of the function 'IsIgnoredTagKey' for key %s is not as expected, "+
+				"want %s, but got %s", key, green(expected), yellow(result))
+		}
+	}
+	t.Logf("The processing result of function 'IsIgnoredTagKey' meets expectation")
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"math/big"
 	"net/http"
+	"net/url"
 	"os"
 	"reflect"
 	"regexp"
@@ -461,6 +462,84 @@ func FlattenResponse(resp *http.Response) (interface{}, error) {
 	return respBody, nil
 }
 
+// BuildQueryParams builds the query parameters (which start with '&') from the non-empty values, the parameters are
+// sorted by the keys and the values are escaped.
+func BuildQueryParams(params map[string]interface{}) string {
+	values := url.Values{}
+	for k, v := range params {
+		if v == nil || v == "" {
+			continue
+		}
+		values.Set(k, fmt.Sprintf("%v", v))
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "&" + values.Encode()
+}
+
+// IsFieldsMatched returns whether the fields of the resource equal to all the non-empty filter values, the keys of the
+// filters are the JMESPath expressions of the fields.
+func IsFieldsMatched(resource interface{}, filters map[string]string) bool {
+	for k, v := range filters {
+		if v != "" && fmt.Sprintf("%v", PathSearch(k, resource, "")) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// ListWithMarker queries all the pages of the resources which are paginated by the marker, the path must contain the
+// query parameters (at least the limit) and the resources are returned from the key of each response body. The APIs
+// which do not support pagination return all the resources in the first page.
+func ListWithMarker(client *golangsdk.ServiceClient, path, key string) ([]interface{}, error) {
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	result := make([]interface{}, 0)
+	marker := ""
+	for {
+		requestPath := path
+		if marker != "" {
+			requestPath += "&marker=" + url.QueryEscape(marker)
+		}
+		resp, err := client.Request("GET", requestPath, &opt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		resources, ok := PathSearch(key, respBody, make([]interface{}, 0)).([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the value of %s in the response of %s is not a list", key, requestPath)
+		}
+		result = append(result, resources...)
+
+		// the next marker is the ID of the last resource, or the offset of the next page for some APIs
+		var nextMarker string
+		switch v := PathSearch("page_info.next_marker", respBody, "").(type) {
+		case string:
+			nextMarker = v
+		case float64:
+			nextMarker = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			return nil, fmt.Errorf("the next marker in the response of %s is neither a string nor a number",
+				requestPath)
+		}
+		// stop querying if the API returns the same marker or an empty page, otherwise the loop will never end
+		if nextMarker == "" || nextMarker == marker || len(resources) == 0 {
+			return result, nil
+		}
+		marker = nextMarker
+	}
+}
+
 // Reverse is a function that used to reverse the order of the characters in the given string.
 func Reverse(s string) string {
 	bs := []byte(s)
@@ -2,8 +2,12 @@ package utils
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
+
+	"github.com/chnsz/golangsdk"
+	th "github.com/chnsz/golangsdk/testhelper"
 )
 
 const (
@@ -81,3 +85,157 @@ func TestAccFunction_jsonStringsEqual(t *testing.T) {
 	}
 	t.Logf("The processing result of function 'JSONStringsEqual' meets expectation: %s", green(true))
 }
+
+func TestAccFunction_buildQueryParams(t *testing.T) {
+	var (
+		testInput = map[string]interface{}{
+			"status":     "ACTIVE",
+			"name":       "test name&id=1",
+			"id":         "",
+			"limit":      10,
+			"hosting_id": nil,
+		}
+		expected = "&limit=10&name=test+name%26id%3D1&status=ACTIVE"
+	)
+
+	result := BuildQueryParams(testInput)
+	if result != expected {
+		t.Fatalf("The processing result of the function 'BuildQueryParams' is not as expected, want '%s', "+
+			"but got '%s'", green(expected), yellow(result))
+	}
+	t.Logf("The processing result of function 'BuildQueryParams' meets expectation: %s", green(expected))
+}
+
+func TestAccFunction_isFieldsMatched(t *testing.T) {
+	resource := map[string]interface{}{
+		"name":      "test",
+		"bandwidth": float64(100),
+		"peer": map[string]interface{}{
+			"status": "ACTIVE",
+		},
+	}
+
+	testCases := []struct {
+		filters  map[string]string
+		expected bool
+	}{
+		{map[string]string{"name": "test", "peer.status": "ACTIVE"}, true},
+		{map[string]string{"name": "", "bandwidth": "100"}, true},
+		{map[string]string{"peer.status": "DOWN"}, false},
+		{map[string]string{"type": "standard"}, false},
+	}
+	for _, tc := range testCases {
+		if result := IsFieldsMatched(resource, tc.filters); result != tc.expected {
+			t.Fatalf("The processing result of the function 'IsFieldsMatched' is not as expected for filters %v, "+
+				"want '%v', but got '%v'", tc.filters, green(tc.expected), yellow(result))
+		}
+	}
+	t.Logf("The processing result of function 'IsFieldsMatched' meets expectation")
+}
+
+func TestAccFunction_listWithMarker(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/project-id/resources", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("marker") {
+		case "":
+			fmt.Fprint(w, `{"resources":[{"id":"1"}],"page_info":{"next_marker":"a+b/c"}}`)
+		case "a+b/c":
+			fmt.Fprint(w, `{"resources":[{"id":"2"}],"page_info":{}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       th.Endpoint(),
+	}
+	result, err := ListWithMarker(client, client.Endpoint+"v3/{project_id}/resources?limit=1", "resources")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(result))
+	th.AssertEquals(t, "2", PathSearch("id", result[1], "").(string))
+}
+
+func TestAccFunction_listWithMarkerRepeatedMarker(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/project-id/resources", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// the API always returns the same marker, e.g. the last resource ID
+		fmt.Fprint(w, `{"resources":[{"id":"1"}],"page_info":{"next_marker":"1"}}`)
+	})
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       th.Endpoint(),
+	}
+	result, err := ListWithMarker(client, client.Endpoint+"v3/{project_id}/resources?limit=1", "resources")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(result))
+}
+
+func TestAccFunction_listWithMarkerInvalidResponse(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/project-id/null", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"resources":null,"page_info":{"next_marker":null}}`)
+	})
+	th.Mux.HandleFunc("/v3/project-id/object", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"resources":{"id":"1"}}`)
+	})
+	th.Mux.HandleFunc("/v3/project-id/marker", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"resources":[{"id":"1"}],"page_info":{"next_marker":{"id":"1"}}}`)
+	})
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       th.Endpoint(),
+	}
+	result, err := ListWithMarker(client, client.Endpoint+"v3/{project_id}/null?limit=1", "resources")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(result))
+
+	_, err = ListWithMarker(client, client.Endpoint+"v3/{project_id}/object?limit=1", "resources")
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = ListWithMarker(client, client.Endpoint+"v3/{project_id}/marker?limit=1", "resources")
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestAccFunction_listWithMarkerNumericMarker(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2/project-id/configs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// the marker is the offset of the next page, and the last page is empty
+		switch r.URL.Query().Get("marker") {
+		case "":
+			fmt.Fprint(w, `{"configs":[{"id":"1"},{"id":"2"}],"page_info":{"next_marker":2}}`)
+		case "2":
+			fmt.Fprint(w, `{"configs":[{"id":"3"}],"page_info":{"next_marker":3}}`)
+		case "3":
+			fmt.Fprint(w, `{"configs":[],"page_info":{"next_marker":3}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       th.Endpoint(),
+	}
+	result, err := ListWithMarker(client, client.Endpoint+"v2/{project_id}/configs?limit=2", "configs")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(result))
+	th.AssertEquals(t, "3", PathSearch("id", result[2], "").(string))
+}